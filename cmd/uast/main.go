@@ -13,8 +13,11 @@ import (
 	"os"
 	"runtime/debug"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
-	"github.com/aneri0x4f/uast-cli/internal/utils"
+	"github.com/aneri0x4f/uast-cli/uast"
+	"golang.org/x/text/transform"
 	"golang.org/x/text/unicode/norm"
 )
 
@@ -30,76 +33,92 @@ func flushBuf(buf *bufio.ReadWriter) {
 	}
 }
 
-func main() {
-	const (
-		UAST       string = "uast"
-		IAST       string = "iast"
-		UAST_IO    string = "uast-io"
-		SLP1       string = "slp"
-		GUJARATI   string = "gu"
-		TAMIL      string = "ta"
-		KANNADA    string = "kn"
-		ODIA       string = "or"
-		TELUGU     string = "te"
-		MALAYALAM  string = "ml"
-		DEVANĀGARĪ string = "devanāgarī"
-	)
+func logLosses(losses []uast.Loss) {
+	for _, v := range losses {
+		log.Printf(
+			"%q is written as %q and cannot be converted back (%v time(s))",
+			v.Letter,
+			v.Written,
+			v.Count,
+		)
+	}
+}
+
+// Count the losses of a whole file, line by line
+func countLosses(t *uast.Transliterator, r io.Reader) ([]uast.Loss, error) {
+	var ans []uast.Loss
+	index := map[string]int{}
+
+	br := bufio.NewReader(r)
+	for {
+		s, err := br.ReadString('\n')
+
+		for _, v := range t.Lossy(s) {
+			if i, ok := index[v.Letter]; ok {
+				ans[i].Count += v.Count
+				continue
+			}
+
+			index[v.Letter] = len(ans)
+			ans = append(ans, v)
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return ans, nil
+			}
+			return ans, err
+		}
+	}
+}
 
-	from_schemes := []string{
-		UAST,
-		UAST_IO,
-		DEVANĀGARĪ,
-		IAST,
-		SLP1,
-		GUJARATI,
-		ODIA,
-		TAMIL,
-		TELUGU,
-		MALAYALAM,
-		KANNADA,
+// Move the positions in an error from checking a single line, which starts
+// at the given column of a line of the input and at the given byte offset,
+// to the whole input
+func moveUnmapped(err error, line, column, offset int) error {
+	var e *uast.UnmappedError
+	if !errors.As(err, &e) {
+		return err
 	}
-	to_schemes := []string{
-		UAST,
-		DEVANĀGARĪ,
-		IAST,
-		GUJARATI,
-		TAMIL,
-		MALAYALAM,
-		KANNADA,
-		TELUGU,
-		ODIA,
+
+	for i := range e.Unmapped {
+		e.Unmapped[i].Line = line
+		e.Unmapped[i].Column += column - 1
+		e.Unmapped[i].Offset += offset
 	}
 
+	return e
+}
+
+func main() {
 	from := flag.String(
 		"from",
-		UAST_IO,
+		uast.UAST_IO,
 		fmt.Sprintf(
 			"from schema (%v)",
-			from_schemes,
+			uast.FromSchemes(),
 		),
 	)
 	to := flag.String(
 		"to",
-		DEVANĀGARĪ,
+		uast.DEVANĀGARĪ,
 		fmt.Sprintf(
 			"to schema (%v)",
-			to_schemes,
+			uast.ToSchemes(),
 		),
 	)
 
 	input := flag.String("i", "", "Input file")
 	output := flag.String("o", "", "Output file")
 	ver := flag.Bool("v", false, "version")
+	strict := flag.Bool("strict", false, "fail on input that cannot be mapped")
+	echo := flag.Bool("echo", false, "repeat the vowel before a final visarga in IPA")
+	lossy := flag.Bool("lossy", false, "report letters that cannot be converted back")
+	schemeDir := flag.String("scheme-dir", "", "Directory of JSON scheme definitions")
+	nasals := flag.String("nasals", "", "write nasals before stops as the nasal of their class (class) or as anusvāra (anusvara)")
 
 	flag.Parse()
 
-	if *from == "devanagari" {
-		*from = DEVANĀGARĪ
-	}
-	if *to == "devanagari" {
-		*to = DEVANĀGARĪ
-	}
-
 	buf := bufio.NewReadWriter(
 		bufio.NewReader(os.Stdin),
 		bufio.NewWriter(os.Stdout),
@@ -129,65 +148,93 @@ func main() {
 		return
 	}
 
-	switch *from {
-	case
-		UAST,
-		UAST_IO,
-		DEVANĀGARĪ,
-		IAST,
-		SLP1,
-		GUJARATI,
-		ODIA,
-		TAMIL,
-		TELUGU,
-		MALAYALAM,
-		KANNADA:
-		writeBuf(buf, "`from`: "+*from+"\n")
+	if *schemeDir != "" {
+		if _, err := uast.LoadSchemeDir(*schemeDir); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var n uast.Nasals
+	switch *nasals {
+	case "":
+		n = uast.NasalsAsIs
+	case "class":
+		n = uast.NasalsClass
+	case "anusvara":
+		n = uast.NasalsAnusvāra
 	default:
-		log.Fatalf("bad `from` value: %v: expected %v", *from, from_schemes)
+		log.Fatalf("bad `nasals` value: %v: expected class or anusvara", *nasals)
 	}
 
-	switch *to {
-	case
-		UAST,
-		DEVANĀGARĪ,
-		IAST,
-		GUJARATI,
-		TAMIL,
-		MALAYALAM,
-		KANNADA,
-		TELUGU,
-		ODIA:
-		writeBuf(buf, "`to`: "+*to+"\n")
+	var t *uast.Transliterator
+	var err error
+	switch {
+	case *echo && *to != uast.IPA && *to != uast.IPA_VEDIC:
+		log.Fatalf("`-echo` needs `-to` %v or %v", uast.IPA, uast.IPA_VEDIC)
+	case *echo && n != uast.NasalsAsIs:
+		log.Fatalf("`-echo` cannot be used with `-nasals`")
+	case *echo:
+		t, err = uast.NewIPA(*from, uast.IPAConvention{Vedic: *to == uast.IPA_VEDIC, Echo: true})
 	default:
-		log.Fatalf("bad `to` value: %v: expected %v", *to, to_schemes)
+		t, err = uast.NewNasals(*from, *to, n)
 	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	writeBuf(buf, "`from`: "+t.From()+"\n")
+	writeBuf(buf, "`to`: "+t.To()+"\n")
 
 	if *input != "" && *output != "" {
-		f, err := os.ReadFile(*input)
+		in, err := os.Open(*input)
 		if err != nil {
 			log.Fatal(err)
 		}
+		defer in.Close()
 
-		if k, ok := utils.Convertors[*from][*to]; ok {
-			var ans []string
+		out, err := os.Create(*output)
+		if err != nil {
+			log.Fatal(err)
+		}
 
-			for i := range strings.SplitSeq(norm.NFC.String(string(f)), "\n") {
-				var arr []string
+		tr := t.Transformer()
+		if *strict {
+			tr = t.StrictTransformer()
+		}
 
-				for j := range strings.SplitSeq(i, " ") {
-					for _, f := range k {
-						j = f(j)
-					}
-					arr = append(arr, j)
-				}
+		chain := []transform.Transformer{tr}
+		if !uast.IsBytes(t.From()) {
+			chain = append([]transform.Transformer{norm.NFC}, chain...)
+		}
+		if !uast.IsBytes(t.To()) {
+			chain = append(chain, norm.NFC)
+		}
 
-				ans = append(ans, norm.NFC.String(strings.Join(arr, " ")))
+		_, err = io.Copy(
+			out,
+			transform.NewReader(
+				in,
+				transform.Chain(chain...),
+			),
+		)
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(*output)
+			log.Fatal(err)
+		}
+
+		if *lossy {
+			if _, err := in.Seek(0, io.SeekStart); err != nil {
+				log.Fatal(err)
 			}
 
-			if os.WriteFile(*output, []byte(strings.Join(ans, "\n")), 0666) != nil {
+			losses, err := countLosses(t, in)
+			if err != nil {
 				log.Fatal(err)
 			}
+			logLosses(losses)
 		}
 
 		return
@@ -199,6 +246,7 @@ func main() {
 
 	flushBuf(buf)
 
+	line, offset := 0, 0
 	for {
 		s, err := buf.ReadString('\n')
 		if err != nil {
@@ -211,26 +259,36 @@ func main() {
 			return
 		}
 
-		var arr []string
-		l := strings.SplitSeq(norm.NFC.String(strings.TrimSpace(s)), " ")
+		line++
+		start := offset
+		offset += len(s)
 
-		for v := range l {
-			if k, ok := utils.Convertors[*from][*to]; ok {
-				for _, f := range k {
-					v = f(v)
-				}
+		trimmed := strings.TrimLeftFunc(s, unicode.IsSpace)
+		lead := s[:len(s)-len(trimmed)]
+		s = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+
+		var ans string
+		if *strict {
+			if ans, err = t.TransliterateStrict(s); err != nil {
+				log.Print(moveUnmapped(err, line, utf8.RuneCountInString(lead)+1, start+len(lead)))
+				continue
 			}
-			arr = append(arr, norm.NFC.String(v))
+		} else {
+			ans = t.Transliterate(s)
 		}
 
 		writeBuf(
 			buf,
 			fmt.Sprintf(
 				"%v\n",
-				strings.Join(arr, " "),
+				ans,
 			),
 		)
 
 		flushBuf(buf)
+
+		if *lossy {
+			logLosses(t.Lossy(s))
+		}
 	}
 }
@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Unmapped is a sequence of the input that the source scheme has no mapping
+// for, and which would otherwise be silently dropped during conversion.
+type Unmapped struct {
+	// Text is the unmapped rune or sequence
+	Text string
+	// Line is the 1-based line number
+	Line int
+	// Column is the 1-based column, counted in runes
+	Column int
+	// Offset is the byte offset into the input
+	Offset int
+}
+
+// A checker returns the byte ranges of a word that cannot be mapped
+type checker = func(string) [][2]int
+
+// Create a checker that accepts only the runes in the given alphabet
+func createRuneChecker(alphabet map[rune]bool) checker {
+	return func(s string) [][2]int {
+		var ans [][2]int
+
+		for i, v := range s {
+			if alphabet[v] {
+				continue
+			}
+
+			end := i + utf8.RuneLen(v)
+			if len(ans) > 0 && ans[len(ans)-1][1] == i {
+				ans[len(ans)-1][1] = end
+				continue
+			}
+
+			ans = append(ans, [2]int{i, end})
+		}
+
+		return ans
+	}
+}
+
+// Collect the runes of every key of the given maps
+func alphabetOf(dicts ...charMap) map[rune]bool {
+	m := map[rune]bool{}
+
+	for _, d := range dicts {
+		for k := range d {
+			for _, v := range k {
+				m[v] = true
+			}
+		}
+	}
+
+	return m
+}
+
+func addRunes(m map[rune]bool, runes ...string) map[rune]bool {
+	for _, s := range runes {
+		for _, v := range s {
+			m[v] = true
+		}
+	}
+
+	return m
+}
+
+// Create a checker for UAST, where `/.../` escapes must name a known
+// character
+func createUASTChecker(io bool) checker {
+	langDict := charMap{"om": "", "'": ""}
+	for k := range unicodeMap {
+		langDict[k] = ""
+	}
+
+	alphabet := addRunes(
+		map[rune]bool{},
+		"abcdeghijklmnoprstuvy",
+		"ABCDEGHIJKLMNOPRSTUVY",
+		"0123456789",
+		"\\-'`",
+	)
+	addRunes(alphabet, allowedSymbols...)
+	addRunes(alphabet, vedicMarks...)
+
+	if io {
+		addRunes(alphabet, ".")
+	}
+
+	rest := createRuneChecker(alphabet)
+
+	return func(s string) [][2]int {
+		var ans [][2]int
+
+		add := func(r [2]int) {
+			if len(ans) > 0 && ans[len(ans)-1][1] == r[0] {
+				ans[len(ans)-1][1] = r[1]
+				return
+			}
+			ans = append(ans, r)
+		}
+
+		start := 0
+		for start < len(s) {
+			i := strings.IndexByte(s[start:], '/')
+			if i < 0 {
+				for _, r := range rest(s[start:]) {
+					add([2]int{start + r[0], start + r[1]})
+				}
+				break
+			}
+
+			for _, r := range rest(s[start : start+i]) {
+				add([2]int{start + r[0], start + r[1]})
+			}
+
+			open := start + i
+			end := len(s)
+			char := s[open+1:]
+			if j := strings.IndexByte(s[open+1:], '/'); j >= 0 {
+				end = open + 1 + j + 1
+				char = s[open+1 : open+1+j]
+			}
+
+			if _, ok := langDict[strings.ToLower(char)]; !ok {
+				add([2]int{open, end})
+			}
+
+			start = end
+		}
+
+		return ans
+	}
+}
+
+// Check returns every sequence of text that the given source scheme cannot
+// map. Words are separated by spaces and lines by newlines, as in the
+// conversion itself.
+func Check(scheme string, text string) []Unmapped {
+	var check checker
+
+	mu.RLock()
+	if n, ok := graph.nodes[scheme]; ok {
+		check = n.check
+	}
+	mu.RUnlock()
+
+	if check == nil {
+		return nil
+	}
+
+	var ans []Unmapped
+
+	offset := 0
+	for lineNo, line := range strings.Split(text, "\n") {
+		body := strings.TrimSuffix(line, "\r")
+
+		wordOffset := 0
+		for word := range strings.SplitSeq(body, " ") {
+			for _, r := range check(word) {
+				start := wordOffset + r[0]
+				ans = append(ans, Unmapped{
+					Text:   word[r[0]:r[1]],
+					Line:   lineNo + 1,
+					Column: utf8.RuneCountInString(body[:start]) + 1,
+					Offset: offset + start,
+				})
+			}
+
+			wordOffset += len(word) + 1
+		}
+
+		offset += len(line) + 1
+	}
+
+	return ans
+}
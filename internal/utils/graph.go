@@ -0,0 +1,324 @@
+package utils
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Guards graph and the tables of scripts loaded at runtime
+var mu sync.RWMutex
+
+// The tables of devanāgarī, which RegisterScheme never replaces, so that
+// they can be read without mu
+var (
+	saVowels     = charDict[sa].vowels
+	saVowelSigns = charDict[sa].vowelSigns
+	saConsonants = charDict[sa].consonants
+)
+
+// A conversion from one scheme directly to another
+type edge struct {
+	to    string
+	funcs []func(string) string
+}
+
+type node struct {
+	check checker
+	edges []edge
+}
+
+// Schemes are nodes and primitive conversions are edges. The conversion for
+// any pair of schemes is the shortest path between them.
+type schemeGraph struct {
+	order []string
+	nodes map[string]*node
+}
+
+func (g *schemeGraph) addScheme(name string, check checker) {
+	if n, ok := g.nodes[name]; ok {
+		n.check = check
+		return
+	}
+
+	g.order = append(g.order, name)
+	g.nodes[name] = &node{check: check}
+}
+
+func (g *schemeGraph) addEdge(from, to string, funcs ...func(string) string) {
+	n := g.nodes[from]
+	n.edges = slices.DeleteFunc(n.edges, func(e edge) bool {
+		return e.to == to
+	})
+	n.edges = append(n.edges, edge{to: to, funcs: funcs})
+}
+
+// Breadth-first search, so that ties are broken by the order of edges
+func (g *schemeGraph) route(from, to string) ([]func(string) string, bool) {
+	if _, ok := g.nodes[from]; !ok {
+		return nil, false
+	}
+	if _, ok := g.nodes[to]; !ok {
+		return nil, false
+	}
+
+	if from == to {
+		return []func(string) string{}, true
+	}
+
+	prev := map[string]string{from: ""}
+	via := map[string][]func(string) string{}
+	queue := []string{from}
+
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		for _, e := range g.nodes[curr].edges {
+			if _, ok := prev[e.to]; ok {
+				continue
+			}
+
+			prev[e.to] = curr
+			via[e.to] = e.funcs
+
+			if e.to != to {
+				queue = append(queue, e.to)
+				continue
+			}
+
+			var path [][]func(string) string
+			for v := to; v != from; v = prev[v] {
+				path = append(path, via[v])
+			}
+			slices.Reverse(path)
+
+			return slices.Concat(path...), true
+		}
+	}
+
+	return nil, false
+}
+
+var graph = func() *schemeGraph {
+	g := &schemeGraph{nodes: map[string]*node{}}
+
+	g.addScheme("uast", createUASTChecker(false))
+	g.addScheme("uast-io", createUASTChecker(true))
+	g.addScheme(
+		"devanāgarī",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(devanāgarīDataDict),
+				append(slices.Collect(maps.Values(accentDict)), allowedSymbols...)...,
+			),
+		),
+	)
+	g.addScheme(
+		"iast",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(charDict[sa].numbers),
+				slices.Concat([]string{".", "'", "gͫ"}, iastAccents, iastAllowed, allowedSymbols)...,
+			),
+		),
+	)
+	g.addScheme(
+		"iso",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(charDict[sa].numbers, withCapitals(isoDataDict)),
+				slices.Concat([]string{".", "'"}, iastAccents, iastAllowed, allowedSymbols)...,
+			),
+		),
+	)
+	g.addScheme(
+		"slp",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(slpDataDict, charDict[sa].numbers),
+				slices.Concat([]string{"."}, vedicMarks, allowedSymbols)...,
+			),
+		),
+	)
+	g.addScheme(
+		"hk",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(hkDataDict, charDict[sa].numbers),
+				slices.Concat(iastAccents, allowedSymbols)...,
+			),
+		),
+	)
+	g.addScheme(
+		"itrans",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(itransDataDict, itransAliasDict, charDict[sa].numbers),
+				slices.Concat(vedicMarks, allowedSymbols)...,
+			),
+		),
+	)
+	g.addScheme(
+		"velthuis",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(velthuisDataDict, velthuisAliasDict, charDict[sa].numbers),
+				slices.Concat(iastAccents, allowedSymbols)...,
+			),
+		),
+	)
+	g.addScheme(
+		"wx",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(wxDataDict, charDict[sa].numbers),
+				slices.Concat(iastAccents, allowedSymbols)...,
+			),
+		),
+	)
+	g.addScheme(
+		"cyrl",
+		createRuneChecker(
+			addRunes(
+				alphabetOf(withCapitals(cyrlDataDict), charDict[sa].numbers),
+				slices.Concat(vedicMarks, allowedSymbols)...,
+			),
+		),
+	)
+	g.addScheme("krutidev", createRuneChecker(addRunes(alphabetOf(krutiDevDataDict, charDict[sa].numbers), allowedSymbols...)))
+	g.addScheme("iscii", checkISCII)
+	g.addScheme("ipa", nil)
+	g.addScheme("ipa-vedic", nil)
+
+	g.addEdge("uast-io", "iast", builderFuncs[sa][hu])
+	g.addEdge("iast", "uast", iastToUAST)
+	g.addEdge("slp", "iast", accentReplacer.Replace, slpToIAST, diphthongAccentsOnA)
+	g.addEdge("iast", "slp", strings.ToLower, splitAccents, createRomanFallback("slp"), iastToSLP)
+	g.addEdge("hk", "iast", accentReplacer.Replace, hkToIAST, diphthongAccentsOnA)
+	g.addEdge("iast", "hk", strings.ToLower, splitAccents, createRomanFallback("hk"), iastToHK, diphthongAccentsOnA)
+	g.addEdge("itrans", "iast", accentReplacer.Replace, itransToIAST, diphthongAccentsOnA)
+	g.addEdge("iast", "itrans", splitAccents, createRomanFallback("itrans"), iastToITRANS)
+	g.addEdge("velthuis", "iast", accentReplacer.Replace, velthuisToIAST, diphthongAccentsOnA)
+	g.addEdge("iast", "velthuis", splitAccents, createRomanFallback("velthuis"), iastToVelthuis, diphthongAccentsOnA)
+	g.addEdge("wx", "iast", accentReplacer.Replace, wxToIAST, diphthongAccentsOnA)
+	g.addEdge("iast", "wx", strings.ToLower, splitAccents, createRomanFallback("wx"), iastToWX)
+	g.addEdge("iso", "iast", accentReplacer.Replace, isoToIAST, diphthongAccentsOnA)
+	g.addEdge("iast", "iso", splitAccents, createRomanFallback("iso"), iastToISO, diphthongAccentsOnA)
+	g.addEdge("cyrl", "iast", cyrlToIAST, diphthongAccentsOnA)
+	g.addEdge("iast", "cyrl", splitAccents, createRomanFallback("cyrl"), iastToCyrl, diphthongAccentsOnA)
+	g.addEdge("iast", "ipa", IPA(false, false))
+	g.addEdge("iast", "ipa-vedic", IPA(true, false))
+	g.addEdge("uast", "iast", builderFuncs[sa][hu], dataToIAST)
+	g.addEdge("uast", "devanāgarī", builderFuncs[sa][hu], builderFuncs[sa][df])
+	g.addEdge("devanāgarī", "uast", devanāgarīToUAST)
+	g.addEdge("krutidev", "devanāgarī", krutiDevToDevanāgarī)
+	g.addEdge("iscii", "devanāgarī", isciiToDevanāgarī)
+	g.addEdge("devanāgarī", "iscii", devanāgarīToISCII)
+
+	for _, lang := range scripts {
+		g.addScript(lang)
+	}
+
+	return g
+}()
+
+// Add a script whose tables are in charDict and devanāgarīScriptDict
+func (g *schemeGraph) addScript(lang langList) {
+	g.addScheme(
+		lang,
+		createRuneChecker(
+			addRunes(
+				alphabetOf(devanāgarīScriptDict[lang]),
+				append(slices.Collect(maps.Values(accentDict)), allowedSymbols...)...,
+			),
+		),
+	)
+
+	h := scriptHooks[lang]
+
+	out := []func(string) string{}
+	if h.out != nil {
+		out = append(out, h.out)
+	}
+
+	in := []func(string) string{}
+	if h.in != nil {
+		in = append(in, h.in)
+	}
+
+	read := builderFuncs[lang][sd]
+	if h.read != nil {
+		read = h.read(lang)
+	}
+
+	g.addEdge("uast", lang, append([]func(string) string{builderFuncs[lang][hu], builderFuncs[lang][df]}, out...)...)
+	g.addEdge("devanāgarī", lang, append([]func(string) string{builderFuncs[lang][ds]}, out...)...)
+	g.addEdge(lang, "devanāgarī", append(in, read)...)
+}
+
+// Route returns the chain of functions converting one scheme to another.
+// Each function holds the read lock while it runs, as the tables it reads
+// may be replaced by RegisterScheme.
+func Route(from, to string) ([]func(string) string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	funcs, ok := graph.route(from, to)
+	if !ok {
+		return nil, false
+	}
+
+	ans := make([]func(string) string, len(funcs))
+	for i, f := range funcs {
+		ans[i] = func(s string) string {
+			mu.RLock()
+			defer mu.RUnlock()
+
+			return f(s)
+		}
+	}
+
+	return ans, true
+}
+
+// Sources returns every scheme that can be converted to another scheme
+func Sources() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var ans []string
+
+	for _, v := range graph.order {
+		if len(graph.nodes[v].edges) > 0 {
+			ans = append(ans, v)
+		}
+	}
+
+	return ans
+}
+
+// Targets returns every scheme that another scheme can be converted to
+func Targets() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	seen := map[string]bool{}
+
+	for _, n := range graph.nodes {
+		for _, e := range n.edges {
+			seen[e.to] = true
+		}
+	}
+
+	var ans []string
+
+	for _, v := range graph.order {
+		if seen[v] {
+			ans = append(ans, v)
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,370 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Conversions applied to the output and to the input of a script, on top of
+// its tables. read, if set, creates the conversion to devanāgarī in place of
+// the one driven by devanāgarīScriptDict.
+type scriptHook struct {
+	out, in func(string) string
+	read    func(langList) func(string) string
+}
+
+var scriptHooks = map[langList]scriptHook{
+	taml:    {out: tamilSuperscriptsAfter, in: tamilSuperscriptsBefore},
+	thai:    {out: thaiVowelsBefore, in: thaiVowelsAfter},
+	khmr:    {out: khmerViriam},
+	mymr:    {out: burmeseMedials},
+	si:      {out: sinhalaJoiners},
+	braille: {out: brailleOut, read: createBrailleReader},
+}
+
+func isTamilSuperscript(r rune) bool {
+	return r == '²' || r == '³' || r == '⁴'
+}
+
+// Move every superscript numeral after the vowel sign or virāma that follows
+// it, as in க³ா → கா³
+func tamilSuperscriptsAfter(s string) string {
+	str := []rune(s)
+
+	for i := 0; i < len(str); i++ {
+		if !isTamilSuperscript(str[i]) {
+			continue
+		}
+
+		j := i
+		for j+1 < len(str) && unicode.Is(unicode.M, str[j+1]) {
+			str[j], str[j+1] = str[j+1], str[j]
+			j++
+		}
+		i = j
+	}
+
+	return string(str)
+}
+
+// Move every superscript numeral back before the vowel signs and virāma that
+// precede it, so that it follows its consonant
+func tamilSuperscriptsBefore(s string) string {
+	if !strings.ContainsAny(s, "²³⁴") {
+		return s
+	}
+
+	str := []rune(s)
+
+	for i := range str {
+		if !isTamilSuperscript(str[i]) {
+			continue
+		}
+
+		for j := i; j > 0 && unicode.Is(unicode.M, str[j-1]); j-- {
+			str[j], str[j-1] = str[j-1], str[j]
+		}
+	}
+
+	return string(str)
+}
+
+const thaiPhinthu = 'ฺ'
+
+func isThaiConsonant(r rune) bool {
+	return r >= 'ก' && r <= 'ฮ'
+}
+
+func isThaiPrebase(r rune) bool {
+	return r >= 'เ' && r <= 'ไ'
+}
+
+// Move e, ai and o before the consonant cluster they follow, as in กฺรเ → เกฺร
+func thaiVowelsBefore(s string) string {
+	str := []rune(s)
+
+	for i := range str {
+		if !isThaiPrebase(str[i]) || i == 0 || !isThaiConsonant(str[i-1]) {
+			continue
+		}
+
+		j := i - 1
+		for j >= 2 && str[j-1] == thaiPhinthu && isThaiConsonant(str[j-2]) {
+			j -= 2
+		}
+
+		v := str[i]
+		copy(str[j+1:i+1], str[j:i])
+		str[j] = v
+	}
+
+	return string(str)
+}
+
+// Move e, ai and o after the consonant cluster they precede
+func thaiVowelsAfter(s string) string {
+	str := []rune(s)
+
+	for i := 0; i < len(str); i++ {
+		if !isThaiPrebase(str[i]) || i+1 == len(str) || !isThaiConsonant(str[i+1]) {
+			continue
+		}
+
+		j := i + 1
+		for j+2 < len(str) && str[j+1] == thaiPhinthu && isThaiConsonant(str[j+2]) {
+			j += 2
+		}
+
+		v := str[i]
+		copy(str[i:j], str[i+1:j+1])
+		str[j] = v
+		i = j
+	}
+
+	return string(str)
+}
+
+// Write a coeng that no consonant or vowel follows as a viriam
+func khmerViriam(s string) string {
+	str := []rune(s)
+
+	for i := range str {
+		if str[i] == '្' && (i+1 == len(str) || str[i+1] < 'ក' || str[i+1] > 'ឳ') {
+			str[i] = '៑'
+		}
+	}
+
+	return string(str)
+}
+
+// Write a zero-width joiner after an al-lakuna that r or y follows, so that
+// they are written as rakāransaya and yansaya, as in ශ්‍රී
+func sinhalaJoiners(s string) string {
+	return strings.NewReplacer("්ර", "්\u200dර", "්ය", "්\u200dය").Replace(s)
+}
+
+var burmeseMedial = map[rune]rune{
+	'ယ': 'ျ',
+	'ရ': 'ြ',
+	'ဝ': 'ွ',
+	'ဟ': 'ှ',
+}
+
+func isBurmeseConsonant(r rune) bool {
+	return (r >= 'က' && r <= 'အ') || r == 'ၐ' || r == 'ၑ'
+}
+
+// Write y, r, v and h after a stacker as medials, and a stacker that no
+// consonant follows as an asat
+func burmeseMedials(s string) string {
+	var ans []rune
+
+	str := []rune(s)
+	for i := 0; i < len(str); i++ {
+		if str[i] != '္' {
+			ans = append(ans, str[i])
+			continue
+		}
+
+		if i+1 == len(str) || !isBurmeseConsonant(str[i+1]) {
+			ans = append(ans, '်')
+			continue
+		}
+
+		if m, ok := burmeseMedial[str[i+1]]; ok {
+			ans = append(ans, m)
+			i++
+			continue
+		}
+
+		ans = append(ans, str[i])
+	}
+
+	return string(ans)
+}
+
+const (
+	brailleNumberSign = "⠼"
+	brailleDigits     = "⠚⠁⠃⠉⠙⠑⠋⠛⠓⠊"
+)
+
+// Report whether a word is a number, i.e. the number sign followed by digits
+func isBrailleNumber(s string) bool {
+	rest, ok := strings.CutPrefix(s, brailleNumberSign)
+	if !ok || rest == "" {
+		return false
+	}
+
+	for _, v := range rest {
+		if !strings.ContainsRune(brailleDigits+brailleNumberSign, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Report whether a rune ends a number in Braille: the daṇḍa cell, or
+// anything outside the Braille block such as punctuation
+func isBrailleBreak(r rune) bool {
+	return r == '⠲' || r < '⠀' || r > '⣿'
+}
+
+// Split Braille into runs of cells and the daṇḍas and punctuation between
+// them, so that a number next to a daṇḍa, as in ⠲⠲⠼⠁⠃⠲⠲, is a run of its own
+func splitBrailleNumbers(s string) []string {
+	var ans []string
+
+	start := 0
+	for i, v := range s {
+		if i > start {
+			r, _ := utf8.DecodeLastRuneInString(s[:i])
+			if isBrailleBreak(r) != isBrailleBreak(v) {
+				ans = append(ans, s[start:i])
+				start = i
+			}
+		}
+	}
+
+	if start < len(s) {
+		ans = append(ans, s[start:])
+	}
+
+	return ans
+}
+
+// Write the number sign once at the start of every number
+func brailleNumbers(s string) string {
+	var ans []string
+
+	for _, v := range splitBrailleNumbers(s) {
+		if isBrailleNumber(v) {
+			v = brailleNumberSign + strings.ReplaceAll(v, brailleNumberSign, "")
+		}
+		ans = append(ans, v)
+	}
+
+	return strings.Join(ans, "")
+}
+
+// Drop the Vedic accents, which Braille has no cells for, and write the
+// number sign once at the start of a number
+func brailleOut(s string) string {
+	for _, v := range accentDict {
+		s = strings.ReplaceAll(s, v, "")
+	}
+
+	return brailleNumbers(s)
+}
+
+// Create a conversion from Braille to devanāgarī. A vowel cell is a vowel
+// sign after a consonant and a vowel anywhere else.
+func createBrailleReader(lang langList) func(string) string {
+	obj := charDict[lang]
+
+	consonants := charMap{}
+	for k, v := range obj.consonants {
+		consonants[v] = charDict[sa].consonants[k]
+	}
+
+	vowels := charMap{}
+	signs := charMap{}
+	for k, v := range obj.vowels {
+		vowels[v] = charDict[sa].vowels[k]
+		signs[v] = charDict[sa].vowelSigns[k]
+	}
+
+	marks := charMap{}
+	for k, v := range obj.vowelSigns {
+		if _, ok := obj.vowels[k]; !ok && v != "" {
+			marks[v] = charDict[sa].vowelSigns[k]
+		}
+	}
+
+	misc := reverseCharMap(charDict[sa].misc)
+	for k, v := range obj.misc {
+		marks[k] = misc[v]
+	}
+
+	digits := charMap{}
+	for k, v := range obj.numbers {
+		digits[strings.TrimPrefix(v, brailleNumberSign)] = charDict[sa].numbers[k]
+	}
+
+	var size int
+	for _, d := range []charMap{consonants, vowels, marks} {
+		for k := range d {
+			size = max(size, utf8.RuneCountInString(k))
+		}
+	}
+
+	read := func(s string) string {
+		if isBrailleNumber(s) {
+			var ans []string
+			for _, v := range strings.ReplaceAll(s, brailleNumberSign, "") {
+				ans = append(ans, digits[string(v)])
+			}
+
+			return strings.Join(ans, "")
+		}
+
+		var str []string
+		for _, v := range s {
+			str = append(str, string(v))
+		}
+
+		var ans []string
+		afterConsonant := false
+
+		for i := 0; i < len(str); {
+			j := min(i+size, len(str))
+			for ; j > i; j-- {
+				k := strings.Join(str[i:j], "")
+
+				if v, ok := consonants[k]; ok {
+					ans = append(ans, v)
+					afterConsonant = true
+					break
+				}
+
+				if v, ok := vowels[k]; ok {
+					if afterConsonant {
+						v = signs[k]
+					}
+					ans = append(ans, v)
+					afterConsonant = false
+					break
+				}
+
+				if v, ok := marks[k]; ok {
+					ans = append(ans, v)
+					afterConsonant = false
+					break
+				}
+			}
+
+			if j == i {
+				if _, ok := slices.BinarySearch(allowedSymbols, str[i]); ok {
+					ans = append(ans, str[i])
+				}
+				afterConsonant = false
+				j = i + 1
+			}
+
+			i = j
+		}
+
+		return strings.Join(ans, "")
+	}
+
+	return func(s string) string {
+		var ans []string
+		for _, v := range splitBrailleNumbers(s) {
+			ans = append(ans, read(v))
+		}
+
+		return strings.Join(ans, "")
+	}
+}
@@ -0,0 +1,219 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// IPA of the IAST letters, shared by every convention
+var ipaDataDict = charMap{
+	"a":  "ɐ",
+	"ā":  "aː",
+	"i":  "i",
+	"ī":  "iː",
+	"u":  "u",
+	"ū":  "uː",
+	"ṛ":  "r̩",
+	"ṝ":  "r̩ː",
+	"ḷ":  "l̩",
+	"ḹ":  "l̩ː",
+	"e":  "eː",
+	"ĕ":  "e",
+	"o":  "oː",
+	"ŏ":  "o",
+	"k":  "k",
+	"kh": "kʰ",
+	"g":  "ɡ",
+	"gh": "ɡʱ",
+	"ṅ":  "ŋ",
+	"ñ":  "ɲ",
+	"ṭ":  "ʈ",
+	"ṭh": "ʈʰ",
+	"ḍ":  "ɖ",
+	"ḍh": "ɖʱ",
+	"ṇ":  "ɳ",
+	"t":  "t̪",
+	"th": "t̪ʰ",
+	"d":  "d̪",
+	"dh": "d̪ʱ",
+	"n":  "n̪",
+	"p":  "p",
+	"ph": "pʰ",
+	"b":  "b",
+	"bh": "bʱ",
+	"m":  "m",
+	"y":  "j",
+	"r":  "ɾ",
+	"l":  "l",
+	"v":  "ʋ",
+	"ś":  "ɕ",
+	"ṣ":  "ʂ",
+	"s":  "s",
+	"h":  "ɦ",
+	"ḻ":  "ɭ",
+	"ẖ":  "x",
+	"ḫ":  "ɸ",
+}
+
+// Letters pronounced differently in Classical Saṃskṛta
+var ipaClassicalDict = charMap{
+	"c":  "t͡ɕ",
+	"ch": "t͡ɕʰ",
+	"j":  "d͡ʑ",
+	"jh": "d͡ʑʱ",
+	"ai": "ɐi",
+	"au": "ɐu",
+}
+
+// Letters pronounced differently in Vedic
+var ipaVedicDict = charMap{
+	"c":  "c",
+	"ch": "cʰ",
+	"j":  "ɟ",
+	"jh": "ɟʱ",
+	"ai": "aːi",
+	"au": "aːu",
+}
+
+// Vowel repeated after a visarga, by the vowel before it
+var ipaEchoDict = charMap{
+	"a":  "ɐ",
+	"ā":  "a",
+	"i":  "i",
+	"ī":  "i",
+	"u":  "u",
+	"ū":  "u",
+	"ṛ":  "i",
+	"ṝ":  "i",
+	"ḷ":  "i",
+	"ḹ":  "i",
+	"e":  "e",
+	"ĕ":  "e",
+	"ai": "i",
+	"o":  "o",
+	"ŏ":  "o",
+	"au": "u",
+}
+
+// Nasal of the class of each stop, for anusvāra before it
+var ipaHomorganicDict = charMap{
+	"k":  "ŋ",
+	"kh": "ŋ",
+	"g":  "ŋ",
+	"gh": "ŋ",
+	"c":  "ɲ",
+	"ch": "ɲ",
+	"j":  "ɲ",
+	"jh": "ɲ",
+	"ṭ":  "ɳ",
+	"ṭh": "ɳ",
+	"ḍ":  "ɳ",
+	"ḍh": "ɳ",
+	"t":  "n̪",
+	"th": "n̪",
+	"d":  "n̪",
+	"dh": "n̪",
+	"p":  "m",
+	"ph": "m",
+	"b":  "m",
+	"bh": "m",
+}
+
+// Put a combining mark, such as the tilde of a nasal vowel, on the first
+// letter of a vowel
+func ipaMark(s, mark string) string {
+	_, size := utf8.DecodeRuneInString(s)
+	return s[:size] + mark + s[size:]
+}
+
+var ipaDanda = strings.NewReplacer("..", "‖", ".", "|")
+
+// Report whether a letter ends the word before it, as the end of the text,
+// a daṇḍa and punctuation other than the hyphen of a compound do
+func ipaWordEnd(next string) bool {
+	if next == "" || next == "‖" {
+		return true
+	}
+
+	_, ok := slices.BinarySearch(allowedSymbols, next)
+	return ok && next != "-"
+}
+
+// IPA converts IAST to IPA, in the Vedic or Classical convention, optionally
+// repeating the vowel before a visarga that ends a word or comes before a
+// daṇḍa or punctuation.
+//
+// In Vedic, c and j are palatal stops, ai and au have a long first element,
+// anusvāra nasalises the vowel before it, and visarga is a velar or a
+// bilabial fricative before k and p. In Classical Saṃskṛta, c and j are
+// affricates and anusvāra is the nasal of the stop that follows it.
+func IPA(vedic, echo bool) func(string) string {
+	dict := ipaClassicalDict
+	if vedic {
+		dict = ipaVedicDict
+	}
+
+	return func(s string) string {
+		letters := iastLetters(splitAccents(ipaDanda.Replace(strings.ToLower(s))))
+
+		var ans []string
+		vowel := ""
+
+		for i, v := range letters {
+			next := ""
+			if i+1 < len(letters) {
+				next = letters[i+1]
+			}
+
+			switch v {
+			case "ṃ", "ṁ":
+				if n, ok := ipaHomorganicDict[next]; ok && !vedic {
+					ans = append(ans, n)
+				} else if len(ans) > 0 && vowel != "" {
+					ans[len(ans)-1] = ipaMark(ans[len(ans)-1], "̃")
+				} else {
+					ans = append(ans, "m")
+				}
+			case "ã":
+				if len(ans) > 0 && vowel != "" {
+					ans[len(ans)-1] = ipaMark(ans[len(ans)-1], "̃")
+				}
+			case "ḥ":
+				switch {
+				case vedic && (next == "k" || next == "kh"):
+					ans = append(ans, "x")
+				case vedic && (next == "p" || next == "ph"):
+					ans = append(ans, "ɸ")
+				default:
+					ans = append(ans, "h")
+				}
+
+				if echo && ipaWordEnd(next) {
+					ans = append(ans, ipaEchoDict[vowel])
+				}
+			case "'":
+			case "\u0301", "\u0300", "\u030b":
+				if len(ans) > 0 && vowel != "" {
+					ans[len(ans)-1] = ipaMark(ans[len(ans)-1], v)
+				}
+			default:
+				if w, ok := dict[v]; ok {
+					ans = append(ans, w)
+				} else if w, ok := ipaDataDict[v]; ok {
+					ans = append(ans, w)
+				} else {
+					ans = append(ans, v)
+				}
+			}
+
+			if _, ok := ipaEchoDict[v]; ok {
+				vowel = v
+			} else if _, ok := uastAccentDict[v]; !ok && v != "ṃ" && v != "ṁ" && v != "ã" {
+				vowel = ""
+			}
+		}
+
+		return strings.Join(ans, "")
+	}
+}
@@ -0,0 +1,342 @@
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Control bytes of ISCII (IS 13194:1991)
+const (
+	isciiHalant = 0xe8
+	isciiNukta  = 0xe9
+	isciiDanda  = 0xea
+	isciiINV    = 0xd9
+	isciiATR    = 0xef
+	isciiEXT    = 0xf0
+)
+
+// ISCII bytes and the devanāgarī they stand for. Every script uses the same
+// bytes, and the script is chosen with ATR.
+var isciiDataDict = map[byte]string{
+	0xa1: "ँ",
+	0xa2: "ं",
+	0xa3: "ः",
+	0xa4: "अ",
+	0xa5: "आ",
+	0xa6: "इ",
+	0xa7: "ई",
+	0xa8: "उ",
+	0xa9: "ऊ",
+	0xaa: "ऋ",
+	0xab: "ऎ",
+	0xac: "ए",
+	0xad: "ऐ",
+	0xae: "ऍ",
+	0xaf: "ऒ",
+	0xb0: "ओ",
+	0xb1: "औ",
+	0xb2: "ऑ",
+	0xb3: "क",
+	0xb4: "ख",
+	0xb5: "ग",
+	0xb6: "घ",
+	0xb7: "ङ",
+	0xb8: "च",
+	0xb9: "छ",
+	0xba: "ज",
+	0xbb: "झ",
+	0xbc: "ञ",
+	0xbd: "ट",
+	0xbe: "ठ",
+	0xbf: "ड",
+	0xc0: "ढ",
+	0xc1: "ण",
+	0xc2: "त",
+	0xc3: "थ",
+	0xc4: "द",
+	0xc5: "ध",
+	0xc6: "न",
+	0xc7: "ऩ",
+	0xc8: "प",
+	0xc9: "फ",
+	0xca: "ब",
+	0xcb: "भ",
+	0xcc: "म",
+	0xcd: "य",
+	0xce: "य़",
+	0xcf: "र",
+	0xd0: "ऱ",
+	0xd1: "ल",
+	0xd2: "ळ",
+	0xd3: "ऴ",
+	0xd4: "व",
+	0xd5: "श",
+	0xd6: "ष",
+	0xd7: "स",
+	0xd8: "ह",
+	0xda: "ा",
+	0xdb: "ि",
+	0xdc: "ी",
+	0xdd: "ु",
+	0xde: "ू",
+	0xdf: "ृ",
+	0xe0: "ॆ",
+	0xe1: "े",
+	0xe2: "ै",
+	0xe3: "ॅ",
+	0xe4: "ॊ",
+	0xe5: "ो",
+	0xe6: "ौ",
+	0xe7: "ॉ",
+	0xe8: "्",
+	0xe9: "़",
+	0xea: "।",
+	0xf1: "०",
+	0xf2: "१",
+	0xf3: "२",
+	0xf4: "३",
+	0xf5: "४",
+	0xf6: "५",
+	0xf7: "६",
+	0xf8: "७",
+	0xf9: "८",
+	0xfa: "९",
+}
+
+// Letters written as a byte followed by nukta
+var isciiNuktaDict = map[byte]string{
+	0xa1: "ॐ",
+	0xa6: "ऌ",
+	0xa7: "ॡ",
+	0xaa: "ॠ",
+	0xdb: "ॢ",
+	0xdc: "ॣ",
+	0xdf: "ॄ",
+	0xe8: "्‍",
+	0xea: "ऽ",
+}
+
+// Scripts selected by the byte after ATR. Roman, 0x40, is plain ASCII.
+var isciiATRDict = map[byte]langList{
+	0x42: "devanāgarī",
+	0x43: bn,
+	0x44: taml,
+	0x45: te,
+	0x46: as,
+	0x47: or,
+	0x48: kn,
+	0x49: ml,
+	0x4a: gu,
+	0x4b: pa,
+}
+
+// Decode ISCII into devanāgarī, ignoring ATR. ASCII is kept, and INV, EXT
+// sequences and unassigned bytes are dropped.
+func isciiToDevanāgarī(s string) string {
+	var ans []string
+
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+
+		var next byte
+		if i+1 < len(s) {
+			next = s[i+1]
+		}
+
+		switch {
+		case b < 0x80:
+			ans = append(ans, s[i:i+1])
+		case b == isciiATR || b == isciiEXT:
+			i++
+		case next == isciiNukta && isciiNuktaDict[b] != "":
+			ans = append(ans, isciiNuktaDict[b])
+			i++
+		case b == isciiHalant && next == isciiHalant:
+			ans = append(ans, "्‌")
+			i++
+		case b == isciiDanda && next == isciiDanda:
+			ans = append(ans, "॥")
+			i++
+		default:
+			ans = append(ans, isciiDataDict[b])
+		}
+	}
+
+	return strings.Join(ans, "")
+}
+
+// Report the bytes of ISCII that stand for nothing
+func checkISCII(s string) [][2]int {
+	var ans [][2]int
+
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+
+		switch {
+		case b < 0x80 || b == isciiINV:
+			continue
+		case b == isciiATR || b == isciiEXT:
+			i++
+			continue
+		}
+
+		if _, ok := isciiDataDict[b]; ok {
+			continue
+		}
+
+		if len(ans) > 0 && ans[len(ans)-1][1] == i {
+			ans[len(ans)-1][1] = i + 1
+			continue
+		}
+		ans = append(ans, [2]int{i, i + 1})
+	}
+
+	return ans
+}
+
+var reverseISCIIDict = func() map[string]string {
+	m := map[string]string{
+		"्‌": string([]byte{isciiHalant, isciiHalant}),
+		"॥":  string([]byte{isciiDanda, isciiDanda}),
+	}
+
+	for k, v := range isciiDataDict {
+		m[v] = string([]byte{k})
+	}
+	for k, v := range isciiNuktaDict {
+		m[v] = string([]byte{k, isciiNukta})
+	}
+
+	return m
+}()
+
+// Encode devanāgarī as ISCII. ASCII is kept and runes that ISCII cannot
+// write are dropped. A vowel sign that does not follow a consonant is
+// written after INV.
+func devanāgarīToISCII(s string) string {
+	var str []string
+	for _, v := range s {
+		str = append(str, string(v))
+	}
+
+	var ans []string
+	base := false
+
+	for i := 0; i < len(str); {
+		j := min(i+2, len(str))
+		for ; j > i; j-- {
+			if _, ok := reverseISCIIDict[strings.Join(str[i:j], "")]; ok {
+				break
+			}
+		}
+
+		if j == i {
+			if r, _ := utf8.DecodeRuneInString(str[i]); r < utf8.RuneSelf {
+				ans = append(ans, str[i])
+			}
+			base = false
+			i++
+			continue
+		}
+
+		v := reverseISCIIDict[strings.Join(str[i:j], "")]
+		b := v[0]
+
+		isSign := b >= 0xa1 && b <= 0xa3 || b >= 0xda && b <= 0xe7 && b != isciiHalant
+		if isSign && !base && len(v) == 1 {
+			ans = append(ans, string([]byte{isciiINV}))
+		}
+
+		ans = append(ans, v)
+		base = b >= 0xa1 && b <= 0xd8 || isSign || b == isciiNukta
+		i = j
+	}
+
+	return strings.Join(ans, "")
+}
+
+// Find the letters that ISCII cannot write, with the devanāgarī they are
+// read back as
+func isciiLossyLetters() map[string]string {
+	m := map[string]string{}
+
+	for _, v := range []charMap{
+		charDict[sa].vowels,
+		charDict[sa].vowelSigns,
+		charDict[sa].consonants,
+	} {
+		for k, d := range v {
+			if d == "" {
+				continue
+			}
+			if w := isciiToDevanāgarī(devanāgarīToISCII(d)); w != d {
+				m[k] = w
+			}
+		}
+	}
+
+	for k, v := range charDict[sa].misc {
+		if w := isciiToDevanāgarī(devanāgarīToISCII(k)); w != k {
+			m[v] = w
+		}
+	}
+
+	// ISCII has no codes for the Vedic accents
+	for _, v := range iastAccentDict {
+		m[v] = ""
+	}
+
+	return m
+}
+
+// ISCIIRun is ISCII text and the script chosen for it by the ATR before it
+type ISCIIRun struct {
+	Script string
+	Text   string
+}
+
+// ISCIIRuns splits ISCII at every ATR. Text before the first ATR is
+// devanāgarī, and an ATR naming no known script keeps the current one.
+func ISCIIRuns(s string) []ISCIIRun {
+	var ans []ISCIIRun
+
+	curr := "devanāgarī"
+	start := 0
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case isciiATR:
+			if i > start {
+				ans = append(ans, ISCIIRun{Script: curr, Text: s[start:i]})
+			}
+			if i+1 < len(s) {
+				if v, ok := isciiATRDict[s[i+1]]; ok {
+					curr = v
+				}
+			}
+
+			i++
+			start = i + 1
+		case isciiEXT:
+			i++
+		}
+	}
+
+	if start < len(s) {
+		ans = append(ans, ISCIIRun{Script: curr, Text: s[start:]})
+	}
+
+	return ans
+}
+
+// ISCIIATR returns the ATR sequence selecting a script, and false for
+// schemes that ISCII has no script for
+func ISCIIATR(scheme string) (string, bool) {
+	for k, v := range isciiATRDict {
+		if v == scheme {
+			return string([]byte{isciiATR, k}), true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"strings"
+)
+
+// Kruti Dev 010 glyphs. The i sign is typed before its consonant and the
+// reph, Z, after its syllable, and both are moved by krutiDevToDevanāgarī.
+var krutiDevDataDict = charMap{
+	"ñ":   "॰",
+	"å":   "०",
+	"ƒ":   "१",
+	"„":   "२",
+	"…":   "३",
+	"†":   "४",
+	"‡":   "५",
+	"ˆ":   "६",
+	"‰":   "७",
+	"Š":   "८",
+	"‹":   "९",
+	"¶+":  "फ़्",
+	"d+":  "क़",
+	"[+k": "ख़",
+	"[+":  "ख़्",
+	"x+":  "ग़",
+	"T+":  "ज़्",
+	"t+":  "ज़",
+	"M+":  "ड़",
+	"<+":  "ढ़",
+	"Q+":  "फ़",
+	";+":  "य़",
+	"j+":  "ऱ",
+	"u+":  "ऩ",
+	"Ùk":  "त्त",
+	"Ù":   "त्त्",
+	"Dr":  "क्त",
+	"–":   "दृ",
+	"—":   "कृ",
+	"é":   "न्न",
+	"™":   "न्न्",
+	"à":   "ह्न",
+	"á":   "ह्य",
+	"â":   "हृ",
+	"ã":   "ह्म",
+	"ºz":  "ह्र",
+	"º":   "ह्",
+	"í":   "द्द",
+	"{k":  "क्ष",
+	"{":   "क्ष्",
+	"=":   "त्र",
+	"«":   "त्र्",
+	"Nî":  "छ्य",
+	"Vî":  "ट्य",
+	"Bî":  "ठ्य",
+	"Mî":  "ड्य",
+	"<î":  "ढ्य",
+	"|":   "द्य",
+	"K":   "ज्ञ",
+	"}":   "द्व",
+	"J":   "श्र",
+	"Vª":  "ट्र",
+	"Mª":  "ड्र",
+	"<ª":  "ढ्र",
+	"Nª":  "छ्र",
+	"Ø":   "क्र",
+	"Ý":   "फ्र",
+	"æ":   "द्र",
+	"ç":   "प्र",
+	"Á":   "प्र",
+	"xz":  "ग्र",
+	"#":   "रु",
+	":":   "रू",
+	"v‚":  "ऑ",
+	"vks": "ओ",
+	"vkS": "औ",
+	"vk":  "आ",
+	"v":   "अ",
+	"b±":  "ईं",
+	"Ã":   "ई",
+	"bZ":  "ई",
+	"b":   "इ",
+	"m":   "उ",
+	"Å":   "ऊ",
+	",s":  "ऐ",
+	",":   "ए",
+	"_":   "ऋ",
+	"ô":   "क्क",
+	"d":   "क",
+	"Dk":  "क",
+	"D":   "क्",
+	"[k":  "ख",
+	"[":   "ख्",
+	"x":   "ग",
+	"Xk":  "ग",
+	"X":   "ग्",
+	"Ä":   "घ",
+	"?k":  "घ",
+	"?":   "घ्",
+	"³":   "ङ",
+	"p":   "च",
+	"Pk":  "च",
+	"P":   "च्",
+	"N":   "छ",
+	"t":   "ज",
+	"Tk":  "ज",
+	"T":   "ज्",
+	">":   "झ",
+	"÷":   "झ्",
+	"¥":   "ञ",
+	"ê":   "ट्ट",
+	"ë":   "ट्ठ",
+	"V":   "ट",
+	"B":   "ठ",
+	"ì":   "ड्ड",
+	"ï":   "ड्ढ",
+	"M":   "ड",
+	"<":   "ढ",
+	".k":  "ण",
+	".":   "ण्",
+	"r":   "त",
+	"Rk":  "त",
+	"R":   "त्",
+	"Fk":  "थ",
+	"F":   "थ्",
+	")":   "द्ध",
+	"n":   "द",
+	"/k":  "ध",
+	"èk":  "ध",
+	"/":   "ध्",
+	"è":   "ध्",
+	"u":   "न",
+	"Uk":  "न",
+	"U":   "न्",
+	"i":   "प",
+	"Ik":  "प",
+	"I":   "प्",
+	"Q":   "फ",
+	"¶":   "फ्",
+	"c":   "ब",
+	"Ck":  "ब",
+	"C":   "ब्",
+	"Hk":  "भ",
+	"H":   "भ्",
+	"e":   "म",
+	"Ek":  "म",
+	"E":   "म्",
+	";":   "य",
+	"¸":   "य्",
+	"j":   "र",
+	"y":   "ल",
+	"Yk":  "ल",
+	"Y":   "ल्",
+	"G":   "ळ",
+	"o":   "व",
+	"Ok":  "व",
+	"O":   "व्",
+	"'k":  "श",
+	"'":   "श्",
+	"\"k": "ष",
+	"\"":  "ष्",
+	"l":   "स",
+	"Lk":  "स",
+	"L":   "स्",
+	"g":   "ह",
+	"z":   "्र",
+	"ª":   "्र",
+	"Ó":   "्य",
+	"î":   "्य",
+	"‚":   "ॉ",
+	"ks":  "ो",
+	"kS":  "ौ",
+	"k":   "ा",
+	"h":   "ी",
+	"È":   "ीं",
+	"q":   "ु",
+	"w":   "ू",
+	"`":   "ृ",
+	"s":   "े",
+	"S":   "ै",
+	"a":   "ं",
+	"¡":   "ँ",
+	"%":   "ः",
+	"W":   "ॅ",
+	"~":   "्",
+	"+":   "़",
+	"•":   "ऽ",
+	"·":   "ऽ",
+	"A":   "।",
+	"AA":  "॥",
+	"f":   "ि",
+	"Z":   "Z",
+	"±":   "Zं",
+}
+
+var krutiDevToken = createTokenFunction(krutiDevDataDict, true)
+
+// Devanāgarī signs written after a consonant
+const devanāgarīSigns = "ािीुूृॄॢॣेैॉॊोौॅंँः"
+
+func isDevanāgarīConsonant(r rune) bool {
+	return r >= 'क' && r <= 'ह'
+}
+
+// Convert Kruti Dev 010 to devanāgarī, moving the i sign after the consonant
+// cluster it precedes and the reph before the cluster it follows
+func krutiDevToDevanāgarī(s string) string {
+	str := []rune(krutiDevToken(s))
+
+	// i sign
+	for i := 0; i < len(str); i++ {
+		if str[i] != 'ि' || i+1 == len(str) || !isDevanāgarīConsonant(str[i+1]) {
+			continue
+		}
+
+		j := i + 1
+		for {
+			if j+1 < len(str) && str[j+1] == '़' {
+				j++
+			}
+			if j+2 < len(str) && str[j+1] == '्' && isDevanāgarīConsonant(str[j+2]) {
+				j += 2
+				continue
+			}
+			break
+		}
+
+		copy(str[i:j], str[i+1:j+1])
+		str[j] = 'ि'
+		i = j
+	}
+
+	// reph
+	for i := 0; i < len(str); i++ {
+		if str[i] != 'Z' {
+			continue
+		}
+
+		j := i
+		for j > 0 && strings.ContainsRune(devanāgarīSigns+"़", str[j-1]) {
+			j--
+		}
+		if j > 0 && isDevanāgarīConsonant(str[j-1]) {
+			j--
+			for j >= 2 && str[j-1] == '्' && isDevanāgarīConsonant(str[j-2]) {
+				j -= 2
+			}
+		}
+
+		copy(str[j+1:i+1], str[j:i])
+		str[j] = 'Z'
+	}
+
+	return strings.NewReplacer("Z", "र्", "्ा", "").Replace(string(str))
+}
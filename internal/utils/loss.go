@@ -0,0 +1,171 @@
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Loss is a letter that a script or romanisation cannot write distinctly,
+// because it has no character for it or shares one with another letter.
+// Converting the script back does not give the letter.
+type Loss struct {
+	// Letter is the IAST letter
+	Letter string
+	// Written is what the script writes for it, empty if it is dropped
+	Written string
+	// Count is the number of times the letter occurs
+	Count int
+}
+
+// Find the letters of a script or romanisation that are not read back as
+// themselves, with what it writes for each
+func lossyLetters(lang langList) map[string]string {
+	if r, ok := romanisations[lang]; ok {
+		letters := reverseCharMap(r.dict)
+
+		m := map[string]string{}
+		for k, v := range romanFallbacks(lang) {
+			if w, ok := letters[v]; ok {
+				m[k] = w
+			} else {
+				m[k] = v
+			}
+		}
+
+		return m
+	}
+
+	if lang == "iscii" {
+		return isciiLossyLetters()
+	}
+
+	obj, ok := charDict[lang]
+	if !ok || lang == sa {
+		return nil
+	}
+
+	dict := devanāgarīScriptDict[lang]
+	m := map[string]string{}
+
+	for _, v := range [][2]charMap{
+		{obj.vowels, charDict[sa].vowels},
+		{obj.vowelSigns, charDict[sa].vowelSigns},
+		{obj.consonants, charDict[sa].consonants},
+	} {
+		for k, d := range v[1] {
+			if d == "" {
+				continue
+			}
+			if _, ok := m[k]; ok {
+				continue
+			}
+
+			w, ok := v[0][k]
+			if !ok {
+				m[k] = v[0][fallbackDict[k]]
+				continue
+			}
+
+			// A script may write a vowel and its sign alike
+			vowel := charDict[sa].vowels[k]
+			if w == "" || (dict[w] != d && (vowel == "" || dict[w] != vowel)) {
+				m[k] = w
+			}
+		}
+	}
+
+	// Signs such as the avagraha that the script has no character for
+	misc := reverseCharMap(obj.misc)
+	for _, v := range charDict[sa].misc {
+		if _, ok := misc[v]; !ok && utf8.RuneCountInString(v) == 1 {
+			m[v] = ""
+		}
+	}
+
+	// Braille has no cells for the Vedic accents
+	if lang == braille {
+		for _, v := range iastAccentDict {
+			m[v] = ""
+		}
+	}
+
+	return m
+}
+
+// Split IAST into the letters of charDict, longest first
+func iastLetters(s string) []string {
+	letters := charMap{}
+	var size int
+
+	for _, v := range []charMap{saVowels, saVowelSigns, saConsonants} {
+		for k := range v {
+			letters[k] = k
+			size = max(size, utf8.RuneCountInString(k))
+		}
+	}
+
+	var str []string
+	for _, v := range iastVedicIn.Replace(s) {
+		str = append(str, string(v))
+	}
+
+	var ans []string
+
+	for i := 0; i < len(str); {
+		j := min(i+size, len(str))
+		for ; j > i+1; j-- {
+			if _, ok := letters[strings.Join(str[i:j], "")]; ok {
+				break
+			}
+		}
+
+		ans = append(ans, strings.Join(str[i:j], ""))
+		i = j
+	}
+
+	return ans
+}
+
+// Lossy returns the letters of text, in the source scheme, that the target
+// script or romanisation cannot write distinctly, in order of first
+// occurrence. It returns nil for the other targets.
+func Lossy(from, to, text string) []Loss {
+	funcs, ok := Route(from, "iast")
+	if !ok {
+		return nil
+	}
+
+	mu.RLock()
+	lossy := lossyLetters(to)
+	mu.RUnlock()
+
+	if len(lossy) == 0 {
+		return nil
+	}
+
+	var ans []Loss
+	index := map[string]int{}
+
+	for _, word := range strings.Fields(text) {
+		for _, f := range funcs {
+			word = f(word)
+		}
+
+		for _, v := range iastLetters(splitAccents(word)) {
+			w, ok := lossy[v]
+			if !ok {
+				continue
+			}
+
+			if i, ok := index[v]; ok {
+				ans[i].Count++
+				continue
+			}
+
+			index[v] = len(ans)
+			ans = append(ans, Loss{Letter: iastVedicOut.Replace(v), Written: w, Count: 1})
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Nasal of the class of each stop, in UAST. Aspirates start with the same
+// letter as the plain stop.
+var classNasalDict = charMap{
+	"k":   "/nu/",
+	"g":   "/nu/",
+	"c":   "/n/",
+	"j":   "/n/",
+	"/t/": "/nl/",
+	"/d/": "/nl/",
+	"t":   "n",
+	"d":   "n",
+	"p":   "m",
+	"b":   "m",
+}
+
+// Split UAST into `/.../` escapes and single runes
+func uastTokens(s string) []string {
+	var ans []string
+
+	for i := 0; i < len(s); {
+		if s[i] == '/' {
+			if j := strings.IndexByte(s[i+1:], '/'); j >= 0 {
+				ans = append(ans, s[i:i+j+2])
+				i += j + 2
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		ans = append(ans, s[i:i+size])
+		i += size
+	}
+
+	return ans
+}
+
+// AnusvāraToNasal writes anusvāra before a stop as the nasal of its class,
+// as in saṃkalpa → saṅkalpa. It converts UAST to UAST.
+func AnusvāraToNasal(s string) string {
+	tokens := uastTokens(s)
+
+	for i, v := range tokens {
+		if v != "/m/" {
+			continue
+		}
+
+		j := i + 1
+		if j < len(tokens) && tokens[j] == "\\" {
+			j++
+		}
+
+		if j < len(tokens) {
+			if n, ok := classNasalDict[tokens[j]]; ok {
+				tokens[i] = n + "-"
+			}
+		}
+	}
+
+	return strings.Join(tokens, "")
+}
+
+// NasalToAnusvāra writes the nasal of a class before a stop of that class as
+// anusvāra, as in saṅkalpa → saṃkalpa. It converts UAST to UAST.
+func NasalToAnusvāra(s string) string {
+	tokens := uastTokens(s)
+
+	var ans []string
+
+	for i := 0; i < len(tokens); i++ {
+		if i+2 < len(tokens) && tokens[i+1] == "-" && classNasalDict[tokens[i+2]] == tokens[i] {
+			ans = append(ans, "/m/")
+			i++
+			continue
+		}
+
+		ans = append(ans, tokens[i])
+	}
+
+	return strings.Join(ans, "")
+}
+
+// KeepCase wraps a conversion of IAST to IAST that writes every letter in
+// lower case, so that the letters in upper case in its input stay so. If
+// the conversion changes the number of runes, only the first is kept.
+func KeepCase(f func(string) string) func(string) string {
+	return func(s string) string {
+		in, out := []rune(s), []rune(f(s))
+		if len(in) != len(out) {
+			in = in[:min(1, len(in))]
+		}
+
+		for i, r := range in {
+			if unicode.IsUpper(r) && i < len(out) {
+				out[i] = unicode.ToUpper(out[i])
+			}
+		}
+
+		return string(out)
+	}
+}
@@ -0,0 +1,218 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// SchemeFile is the JSON definition of a script. The numbers, vowels,
+// vowelSigns and consonants sections map a UAST letter to the characters of
+// the script, and misc maps characters of the script to their UAST value,
+// exactly as in charDict.
+type SchemeFile struct {
+	// Name of the scheme, used as `from` and `to` value
+	Name string `json:"name"`
+	// Base is an existing script whose tables are extended. It defaults to
+	// Name, so that a file can correct a built-in script.
+	Base       string  `json:"base,omitempty"`
+	Numbers    charMap `json:"numbers,omitempty"`
+	Vowels     charMap `json:"vowels,omitempty"`
+	VowelSigns charMap `json:"vowelSigns,omitempty"`
+	Consonants charMap `json:"consonants,omitempty"`
+	Misc       charMap `json:"misc,omitempty"`
+}
+
+// Pair every character of a script with the devanāgarī character of the
+// same UAST letter
+func deriveDevanāgarīScriptDict(obj langMap) charMap {
+	m := charMap{}
+
+	for _, v := range [][2]charMap{
+		{obj.numbers, charDict[sa].numbers},
+		{obj.vowels, charDict[sa].vowels},
+		{obj.vowelSigns, charDict[sa].vowelSigns},
+		{obj.consonants, charDict[sa].consonants},
+	} {
+		for _, k := range slices.Sorted(maps.Keys(v[0])) {
+			if c, d := v[0][k], v[1][k]; c != "" && d != "" {
+				if _, ok := m[c]; !ok {
+					m[c] = d
+				}
+			}
+		}
+	}
+
+	misc := reverseCharMap(charDict[sa].misc)
+	for k, v := range obj.misc {
+		m[k] = misc[v]
+	}
+
+	return m
+}
+
+// Merge a section over the one of the base script, rejecting letters that
+// devanāgarī does not have
+func mergeSection(name, section string, base, over, known charMap) (charMap, error) {
+	m := maps.Clone(base)
+	if m == nil {
+		m = charMap{}
+	}
+
+	for k, v := range over {
+		if _, ok := known[k]; !ok {
+			return nil, fmt.Errorf("scheme %v: unknown letter %q in %v", name, k, section)
+		}
+
+		m[k] = v
+	}
+
+	return m, nil
+}
+
+// RegisterScheme adds a script, or replaces a script with the same name
+func RegisterScheme(s SchemeFile) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if s.Name == "" {
+		return fmt.Errorf("scheme has no name")
+	}
+
+	if _, ok := charDict[s.Name]; s.Name == sa || (!ok && graph.nodes[s.Name] != nil) {
+		return fmt.Errorf("scheme %v: cannot replace a built-in scheme that is not a script", s.Name)
+	}
+
+	base := s.Base
+	if base == "" {
+		base = s.Name
+	}
+	if base == "devanāgarī" {
+		base = sa
+	}
+
+	obj, ok := charDict[base]
+	if !ok && s.Base != "" {
+		return fmt.Errorf("scheme %v: unknown base %v", s.Name, s.Base)
+	}
+
+	var err error
+	var ans langMap
+
+	if ans.numbers, err = mergeSection(s.Name, "numbers", obj.numbers, s.Numbers, charDict[sa].numbers); err != nil {
+		return err
+	}
+	if ans.vowels, err = mergeSection(s.Name, "vowels", obj.vowels, s.Vowels, charDict[sa].vowels); err != nil {
+		return err
+	}
+	if ans.vowelSigns, err = mergeSection(s.Name, "vowelSigns", obj.vowelSigns, s.VowelSigns, charDict[sa].vowelSigns); err != nil {
+		return err
+	}
+	if ans.consonants, err = mergeSection(s.Name, "consonants", obj.consonants, s.Consonants, charDict[sa].consonants); err != nil {
+		return err
+	}
+
+	ans.misc = maps.Clone(obj.misc)
+	if ans.misc == nil {
+		ans.misc = charMap{}
+	}
+
+	misc := reverseCharMap(charDict[sa].misc)
+	for k, v := range s.Misc {
+		if _, ok := misc[v]; !ok {
+			return fmt.Errorf("scheme %v: unknown value %q in misc", s.Name, v)
+		}
+
+		ans.misc[k] = v
+	}
+
+	charDict[s.Name] = ans
+	if h, ok := scriptHooks[base]; ok {
+		scriptHooks[s.Name] = h
+	}
+	devanāgarīScriptDict[s.Name] = deriveDevanāgarīScriptDict(ans)
+	reverseDevanāgarīScriptDict[s.Name] = reverseScriptDict(s.Name)
+	builderFuncs[s.Name] = createBuilder(s.Name)
+	graph.addScript(s.Name)
+
+	return nil
+}
+
+// Variants of Kannada, Telugu and Malayalam that write Saṃskṛta e and o with
+// the long letters, keeping the short letters for ĕ and ŏ, as ISO 15919
+// distinguishes them
+var longVowelSchemes = []SchemeFile{
+	{
+		Name:       "kn-long",
+		Base:       kn,
+		Vowels:     charMap{"e": "ಏ", "ĕ": "ಎ", "o": "ಓ", "ŏ": "ಒ"},
+		VowelSigns: charMap{"e": "ೇ", "ĕ": "ೆ", "o": "ೋ", "ŏ": "ೊ"},
+	},
+	{
+		Name:       "te-long",
+		Base:       te,
+		Vowels:     charMap{"e": "ఏ", "ĕ": "ఎ", "o": "ఓ", "ŏ": "ఒ"},
+		VowelSigns: charMap{"e": "ే", "ĕ": "ె", "o": "ో", "ŏ": "ొ"},
+	},
+	{
+		Name:       "ml-long",
+		Base:       ml,
+		Vowels:     charMap{"e": "ഏ", "ĕ": "എ", "o": "ഓ", "ŏ": "ഒ"},
+		VowelSigns: charMap{"e": "േ", "ĕ": "െ", "o": "ോ", "ŏ": "ൊ"},
+	},
+}
+
+func init() {
+	for _, v := range longVowelSchemes {
+		if err := RegisterScheme(v); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// LoadScheme reads a JSON scheme definition and registers it
+func LoadScheme(r io.Reader) (string, error) {
+	var s SchemeFile
+
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+
+	if err := d.Decode(&s); err != nil {
+		return "", err
+	}
+
+	return s.Name, RegisterScheme(s)
+}
+
+// LoadSchemeDir registers every `*.json` scheme in a directory, in
+// lexical order of file names
+func LoadSchemeDir(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(files)
+
+	var ans []string
+
+	for _, v := range files {
+		f, err := os.Open(v)
+		if err != nil {
+			return ans, err
+		}
+
+		name, err := LoadScheme(f)
+		f.Close()
+		if err != nil {
+			return ans, fmt.Errorf("%v: %w", v, err)
+		}
+
+		ans = append(ans, name)
+	}
+
+	return ans, nil
+}
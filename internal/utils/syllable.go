@@ -0,0 +1,246 @@
+package utils
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Akṣara is a syllable of the input
+type Akṣara struct {
+	// Text is the syllable as written in the input
+	Text string
+	// IAST is the syllable in IAST
+	IAST string
+	// Start and End are the byte offsets of Text into the input
+	Start int
+	End   int
+}
+
+type letterKind int
+
+const (
+	otherLetter letterKind = iota
+	consonantLetter
+	vowelLetter
+	// ayogavāhas and accents, which belong to the vowel before them
+	markLetter
+)
+
+var iastAccentMarks = strings.Join(slices.Sorted(maps.Values(iastAccentDict)), "")
+
+// The base vowel of an IAST letter, without its accent
+func vowelBase(s string) string {
+	return strings.TrimRight(splitAccents(strings.ToLower(s)), iastAccentMarks)
+}
+
+func kindOf(s string) letterKind {
+	l := strings.ToLower(s)
+
+	switch {
+	case saConsonants[l] != "":
+		return consonantLetter
+	case saVowels[vowelBase(s)] != "":
+		return vowelLetter
+	case slices.Contains(ayogavāhas, l), l != "" && strings.Trim(l, iastAccentMarks) == "":
+		return markLetter
+	}
+
+	return otherLetter
+}
+
+// Split an IAST word into syllables, as byte ranges of the word. The
+// consonants before a vowel begin its syllable, and consonants that end the
+// word join the last one. Phonologically, all but the last consonant before
+// a vowel close the syllable before it instead.
+func syllabify(word string, phonological bool) [][2]int {
+	letters := iastLetters(word)
+
+	var kinds []letterKind
+	var offsets []int
+
+	offset := 0
+	for i := 0; i < len(letters); i++ {
+		v := letters[i]
+		kind := kindOf(v)
+
+		// An accent on the a of ai and au
+		if kind == vowelLetter && vowelBase(v) == "a" && strings.ToLower(v) != "a" && i+1 < len(letters) {
+			if next := strings.ToLower(letters[i+1]); next == "i" || next == "u" {
+				v += letters[i+1]
+				letters = slices.Delete(letters, i+1, i+2)
+			}
+		}
+
+		letters[i] = v
+		kinds = append(kinds, kind)
+		offsets = append(offsets, offset)
+		offset += len(v)
+	}
+	offsets = append(offsets, offset)
+
+	var ans [][2]int
+
+	for start := 0; start < len(letters); {
+		if kinds[start] == otherLetter {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(letters) && kinds[end] != otherLetter {
+			end++
+		}
+
+		var run [][2]int
+		curr := start
+
+		for i := start; i < end; i++ {
+			if kinds[i] != vowelLetter {
+				continue
+			}
+
+			j := i + 1
+			for j < end && kinds[j] == markLetter {
+				j++
+			}
+
+			if phonological && len(run) > 0 && i-curr > 1 {
+				run[len(run)-1][1] += i - curr - 1
+				curr = i - 1
+			}
+
+			run = append(run, [2]int{curr, j})
+			curr = j
+			i = j - 1
+		}
+
+		if curr < end {
+			if len(run) > 0 {
+				run[len(run)-1][1] = end
+			} else {
+				run = append(run, [2]int{curr, end})
+			}
+		}
+
+		for _, v := range run {
+			ans = append(ans, [2]int{offsets[v[0]], offsets[v[1]]})
+		}
+
+		start = end
+	}
+
+	return ans
+}
+
+// Runes after a split point across which the conversion is checked
+const splitWindow = 8
+
+// Find the byte offsets of a word where it can be split, keyed by the
+// position of the IAST they fall at. Going through the word once, a position
+// splits it if the part since the last split converts to the IAST that
+// follows, and converting across the position gives the same as converting
+// either side of it.
+func splitPoints(word string, iast string, convert func(string) string) map[int]int {
+	var cands []int
+	valid := utf8.ValidString(word)
+	for i := 0; i <= len(word); i++ {
+		if !valid || i == len(word) || utf8.RuneStart(word[i]) {
+			cands = append(cands, i)
+		}
+	}
+
+	ans := map[int]int{0: 0, len(iast): len(word)}
+
+	last, pos := 0, 0
+	for c := 1; c < len(cands)-1; c++ {
+		i := cands[c]
+
+		part := convert(word[last:i])
+		if part == "" || pos+len(part) >= len(iast) || !strings.HasPrefix(iast[pos:], part) {
+			continue
+		}
+
+		end := cands[min(c+splitWindow, len(cands)-1)]
+		if part+convert(word[i:end]) != convert(word[last:end]) {
+			continue
+		}
+
+		pos += len(part)
+		ans[pos] = i
+		last = i
+	}
+
+	return ans
+}
+
+// Map syllables of the IAST of a word to the word, widening each to the
+// nearest positions where the word can be split and merging those that
+// then overlap
+func alignSyllables(word, iast string, spans [][2]int, points map[int]int, offset int) []Akṣara {
+	var ans []Akṣara
+	var last [2]int
+
+	for _, v := range spans {
+		a, b := v[0], v[1]
+		for _, ok := points[a]; !ok; _, ok = points[a] {
+			a--
+		}
+		for _, ok := points[b]; !ok; _, ok = points[b] {
+			b++
+		}
+
+		start, end := points[a], points[b]
+		if len(ans) > 0 && start < ans[len(ans)-1].End-offset {
+			a = last[0]
+			start = ans[len(ans)-1].Start - offset
+			ans = ans[:len(ans)-1]
+		}
+
+		ans = append(ans, Akṣara{
+			Text:  word[start:end],
+			IAST:  iast[a:b],
+			Start: offset + start,
+			End:   offset + end,
+		})
+		last = [2]int{a, b}
+	}
+
+	return ans
+}
+
+// Akṣaras splits text into orthographic and phonological syllables. convert
+// turns a word of the input into IAST. Words are separated by spaces and
+// lines by newlines, as in the conversion itself, and syllables do not cross
+// them. A syllable that the input cannot be split at, such as within a
+// legacy font glyph, is merged with its neighbour.
+func Akṣaras(text string, convert func(string) string) ([]Akṣara, []Akṣara) {
+	var orthographic []Akṣara
+	var phonological []Akṣara
+
+	offset := 0
+	for line := range strings.SplitSeq(text, "\n") {
+		body := strings.TrimSuffix(line, "\r")
+
+		wordOffset := offset
+		for word := range strings.SplitSeq(body, " ") {
+			iast := convert(word)
+			o := syllabify(iast, false)
+			p := syllabify(iast, true)
+
+			if len(o) > 0 {
+				points := splitPoints(word, iast, convert)
+
+				orthographic = append(orthographic, alignSyllables(word, iast, o, points, wordOffset)...)
+				phonological = append(phonological, alignSyllables(word, iast, p, points, wordOffset)...)
+			}
+
+			wordOffset += len(word) + 1
+		}
+
+		offset += len(line) + 1
+	}
+
+	return orthographic, phonological
+}
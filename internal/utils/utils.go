@@ -10,6 +10,8 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 type charMap = map[string]string
@@ -25,15 +27,57 @@ type langMap = struct {
 type langList = string
 
 const (
-	gu langList = "gu"
-	sa langList = "sa"
-	ml langList = "ml"
-	or langList = "or"
-	te langList = "te"
-	kn langList = "kn"
-	ta langList = "ta"
+	gu      langList = "gu"
+	sa      langList = "sa"
+	ml      langList = "ml"
+	or      langList = "or"
+	te      langList = "te"
+	kn      langList = "kn"
+	ta      langList = "ta"
+	bn      langList = "bn"
+	as      langList = "as"
+	pa      langList = "pa"
+	si      langList = "si"
+	brah    langList = "brah"
+	shrd    langList = "shrd"
+	sidd    langList = "sidd"
+	newa    langList = "newa"
+	gran    langList = "gran"
+	taml    langList = "taml"
+	thai    langList = "thai"
+	khmr    langList = "khmr"
+	bali    langList = "bali"
+	java    langList = "java"
+	mymr    langList = "mymr"
+	braille langList = "braille"
 )
 
+// Scripts converted through devanāgarī
+var scripts = []langList{
+	gu,
+	or,
+	ta,
+	te,
+	ml,
+	kn,
+	bn,
+	as,
+	pa,
+	si,
+	brah,
+	shrd,
+	sidd,
+	newa,
+	gran,
+	taml,
+	thai,
+	khmr,
+	bali,
+	java,
+	mymr,
+	braille,
+}
+
 var charDict = map[langList]langMap{
 	gu: {
 		misc: charMap{
@@ -180,6 +224,9 @@ var charDict = map[langList]langMap{
 			"ṃ":  "𑌂",
 			"ḥ":  "𑌃",
 			"ã":  "𑌁",
+			"ẖ":  "ᳵ",
+			"ḫ":  "ᳶ",
+			"ṁ":  "ꣳ",
 			"-":  "𑍍",
 		},
 		consonants: charMap{
@@ -587,6 +634,1511 @@ var charDict = map[langList]langMap{
 			"ḻ":  "ଳ",
 		},
 	},
+	bn: {
+		misc: charMap{
+			"।":  ".",
+			"॥":  "..",
+			"ঽ":  "'",
+			"ওঁ": "om",
+		},
+		numbers: charMap{
+			"0": "০",
+			"1": "১",
+			"2": "২",
+			"3": "৩",
+			"4": "৪",
+			"5": "৫",
+			"6": "৬",
+			"7": "৭",
+			"8": "৮",
+			"9": "৯",
+		},
+		vowels: charMap{
+			"a":  "অ",
+			"ā":  "আ",
+			"i":  "ই",
+			"ī":  "ঈ",
+			"u":  "উ",
+			"ū":  "ঊ",
+			"ṛ":  "ঋ",
+			"ṝ":  "ৠ",
+			"ḷ":  "ঌ",
+			"ḹ":  "ৡ",
+			"e":  "এ",
+			"ai": "ঐ",
+			"o":  "ও",
+			"au": "ঔ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "া",
+			"i":  "ি",
+			"ī":  "ী",
+			"u":  "ু",
+			"ū":  "ূ",
+			"ṛ":  "ৃ",
+			"ṝ":  "ৄ",
+			"ḷ":  "ৢ",
+			"ḹ":  "ৣ",
+			"e":  "ে",
+			"ai": "ৈ",
+			"o":  "ো",
+			"au": "ৌ",
+			"ṃ":  "ং",
+			"ḥ":  "ঃ",
+			"ã":  "ঁ",
+			"-":  "্",
+		},
+		consonants: charMap{
+			"k":  "ক",
+			"kh": "খ",
+			"g":  "গ",
+			"gh": "ঘ",
+			"ṅ":  "ঙ",
+			"c":  "চ",
+			"ch": "ছ",
+			"j":  "জ",
+			"jh": "ঝ",
+			"ñ":  "ঞ",
+			"ṭ":  "ট",
+			"ṭh": "ঠ",
+			"ḍ":  "ড",
+			"ḍh": "ঢ",
+			"ṇ":  "ণ",
+			"t":  "ত",
+			"th": "থ",
+			"d":  "দ",
+			"dh": "ধ",
+			"n":  "ন",
+			"p":  "প",
+			"ph": "ফ",
+			"b":  "ব",
+			"bh": "ভ",
+			"m":  "ম",
+			"y":  "য",
+			"r":  "র",
+			"l":  "ল",
+			"v":  "ব",
+			"ś":  "শ",
+			"ṣ":  "ষ",
+			"s":  "স",
+			"h":  "হ",
+			"ḻ":  "ল়",
+		},
+	},
+	as: {
+		misc: charMap{
+			"।":  ".",
+			"॥":  "..",
+			"ঽ":  "'",
+			"ওঁ": "om",
+		},
+		numbers: charMap{
+			"0": "০",
+			"1": "১",
+			"2": "২",
+			"3": "৩",
+			"4": "৪",
+			"5": "৫",
+			"6": "৬",
+			"7": "৭",
+			"8": "৮",
+			"9": "৯",
+		},
+		vowels: charMap{
+			"a":  "অ",
+			"ā":  "আ",
+			"i":  "ই",
+			"ī":  "ঈ",
+			"u":  "উ",
+			"ū":  "ঊ",
+			"ṛ":  "ঋ",
+			"ṝ":  "ৠ",
+			"ḷ":  "ঌ",
+			"ḹ":  "ৡ",
+			"e":  "এ",
+			"ai": "ঐ",
+			"o":  "ও",
+			"au": "ঔ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "া",
+			"i":  "ি",
+			"ī":  "ী",
+			"u":  "ু",
+			"ū":  "ূ",
+			"ṛ":  "ৃ",
+			"ṝ":  "ৄ",
+			"ḷ":  "ৢ",
+			"ḹ":  "ৣ",
+			"e":  "ে",
+			"ai": "ৈ",
+			"o":  "ো",
+			"au": "ৌ",
+			"ṃ":  "ং",
+			"ḥ":  "ঃ",
+			"ã":  "ঁ",
+			"-":  "্",
+		},
+		consonants: charMap{
+			"k":  "ক",
+			"kh": "খ",
+			"g":  "গ",
+			"gh": "ঘ",
+			"ṅ":  "ঙ",
+			"c":  "চ",
+			"ch": "ছ",
+			"j":  "জ",
+			"jh": "ঝ",
+			"ñ":  "ঞ",
+			"ṭ":  "ট",
+			"ṭh": "ঠ",
+			"ḍ":  "ড",
+			"ḍh": "ঢ",
+			"ṇ":  "ণ",
+			"t":  "ত",
+			"th": "থ",
+			"d":  "দ",
+			"dh": "ধ",
+			"n":  "ন",
+			"p":  "প",
+			"ph": "ফ",
+			"b":  "ব",
+			"bh": "ভ",
+			"m":  "ম",
+			"y":  "য",
+			"r":  "ৰ",
+			"l":  "ল",
+			"v":  "ৱ",
+			"ś":  "শ",
+			"ṣ":  "ষ",
+			"s":  "স",
+			"h":  "হ",
+			"ḻ":  "ল়",
+		},
+	},
+	// Gurmukhī has no vocalic r and l, no ṣ and no avagraha. Vocalic r and l
+	// are written as r or lr followed by i or ī, as in Panjabi spelling of
+	// Saṃskṛta words, ṣ is written like ś, and the devanāgarī avagraha is kept.
+	// These letters are read back as what they are written with.
+	pa: {
+		misc: charMap{
+			"।":  ".",
+			"॥":  "..",
+			"ऽ":  "'",
+			"ਓਂ": "om",
+		},
+		numbers: charMap{
+			"0": "੦",
+			"1": "੧",
+			"2": "੨",
+			"3": "੩",
+			"4": "੪",
+			"5": "੫",
+			"6": "੬",
+			"7": "੭",
+			"8": "੮",
+			"9": "੯",
+		},
+		vowels: charMap{
+			"a":  "ਅ",
+			"ā":  "ਆ",
+			"i":  "ਇ",
+			"ī":  "ਈ",
+			"u":  "ਉ",
+			"ū":  "ਊ",
+			"ṛ":  "ਰਿ",
+			"ṝ":  "ਰੀ",
+			"ḷ":  "ਲ੍ਰਿ",
+			"ḹ":  "ਲ੍ਰੀ",
+			"e":  "ਏ",
+			"ai": "ਐ",
+			"o":  "ਓ",
+			"au": "ਔ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "ਾ",
+			"i":  "ਿ",
+			"ī":  "ੀ",
+			"u":  "ੁ",
+			"ū":  "ੂ",
+			"ṛ":  "੍ਰਿ",
+			"ṝ":  "੍ਰੀ",
+			"ḷ":  "੍ਲ੍ਰਿ",
+			"ḹ":  "੍ਲ੍ਰੀ",
+			"e":  "ੇ",
+			"ai": "ੈ",
+			"o":  "ੋ",
+			"au": "ੌ",
+			"ṃ":  "ਂ",
+			"ḥ":  "ਃ",
+			"ã":  "ਁ",
+			"-":  "੍",
+		},
+		consonants: charMap{
+			"k":  "ਕ",
+			"kh": "ਖ",
+			"g":  "ਗ",
+			"gh": "ਘ",
+			"ṅ":  "ਙ",
+			"c":  "ਚ",
+			"ch": "ਛ",
+			"j":  "ਜ",
+			"jh": "ਝ",
+			"ñ":  "ਞ",
+			"ṭ":  "ਟ",
+			"ṭh": "ਠ",
+			"ḍ":  "ਡ",
+			"ḍh": "ਢ",
+			"ṇ":  "ਣ",
+			"t":  "ਤ",
+			"th": "ਥ",
+			"d":  "ਦ",
+			"dh": "ਧ",
+			"n":  "ਨ",
+			"p":  "ਪ",
+			"ph": "ਫ",
+			"b":  "ਬ",
+			"bh": "ਭ",
+			"m":  "ਮ",
+			"y":  "ਯ",
+			"r":  "ਰ",
+			"l":  "ਲ",
+			"v":  "ਵ",
+			"ś":  "ਸ਼",
+			"ṣ":  "ਸ਼",
+			"s":  "ਸ",
+			"h":  "ਹ",
+			"ḻ":  "ਲ਼",
+		},
+	},
+	si: {
+		misc: charMap{
+			"।":  ".",
+			"॥":  "..",
+			"ऽ":  "'",
+			"ඕං": "om",
+		},
+		numbers: charMap{
+			"0": "0",
+			"1": "1",
+			"2": "2",
+			"3": "3",
+			"4": "4",
+			"5": "5",
+			"6": "6",
+			"7": "7",
+			"8": "8",
+			"9": "9",
+		},
+		vowels: charMap{
+			"a":  "අ",
+			"ā":  "ආ",
+			"i":  "ඉ",
+			"ī":  "ඊ",
+			"u":  "උ",
+			"ū":  "ඌ",
+			"ṛ":  "ඍ",
+			"ṝ":  "ඎ",
+			"ḷ":  "ඏ",
+			"ḹ":  "ඐ",
+			"e":  "ඒ",
+			"ĕ":  "එ",
+			"ai": "ඓ",
+			"o":  "ඕ",
+			"ŏ":  "ඔ",
+			"au": "ඖ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "ා",
+			"i":  "ි",
+			"ī":  "ී",
+			"u":  "ු",
+			"ū":  "ූ",
+			"ṛ":  "ෘ",
+			"ṝ":  "ෲ",
+			"ḷ":  "ෟ",
+			"ḹ":  "ෳ",
+			"e":  "ේ",
+			"ĕ":  "ෙ",
+			"ai": "ෛ",
+			"o":  "ෝ",
+			"ŏ":  "ො",
+			"au": "ෞ",
+			"ṃ":  "ං",
+			"ḥ":  "ඃ",
+			"ã":  "ඁ",
+			"-":  "්",
+		},
+		consonants: charMap{
+			"k":  "ක",
+			"kh": "ඛ",
+			"g":  "ග",
+			"gh": "ඝ",
+			"ṅ":  "ඞ",
+			"c":  "ච",
+			"ch": "ඡ",
+			"j":  "ජ",
+			"jh": "ඣ",
+			"ñ":  "ඤ",
+			"ṭ":  "ට",
+			"ṭh": "ඨ",
+			"ḍ":  "ඩ",
+			"ḍh": "ඪ",
+			"ṇ":  "ණ",
+			"t":  "ත",
+			"th": "ථ",
+			"d":  "ද",
+			"dh": "ධ",
+			"n":  "න",
+			"p":  "ප",
+			"ph": "ඵ",
+			"b":  "බ",
+			"bh": "භ",
+			"m":  "ම",
+			"y":  "ය",
+			"r":  "ර",
+			"l":  "ල",
+			"v":  "ව",
+			"ś":  "ශ",
+			"ṣ":  "ෂ",
+			"s":  "ස",
+			"h":  "හ",
+			"ḻ":  "ළ",
+		},
+	},
+	brah: {
+		misc: charMap{
+			"𑁇":  ".",
+			"𑁈":  "..",
+			"ऽ":  "'",
+			"𑀑𑀁": "om",
+		},
+		numbers: charMap{
+			"0": "𑁦",
+			"1": "𑁧",
+			"2": "𑁨",
+			"3": "𑁩",
+			"4": "𑁪",
+			"5": "𑁫",
+			"6": "𑁬",
+			"7": "𑁭",
+			"8": "𑁮",
+			"9": "𑁯",
+		},
+		vowels: charMap{
+			"a":  "𑀅",
+			"ā":  "𑀆",
+			"i":  "𑀇",
+			"ī":  "𑀈",
+			"u":  "𑀉",
+			"ū":  "𑀊",
+			"ṛ":  "𑀋",
+			"ṝ":  "𑀌",
+			"ḷ":  "𑀍",
+			"ḹ":  "𑀎",
+			"e":  "𑀏",
+			"ai": "𑀐",
+			"o":  "𑀑",
+			"au": "𑀒",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "𑀸",
+			"i":  "𑀺",
+			"ī":  "𑀻",
+			"u":  "𑀼",
+			"ū":  "𑀽",
+			"ṛ":  "𑀾",
+			"ṝ":  "𑀿",
+			"ḷ":  "𑁀",
+			"ḹ":  "𑁁",
+			"e":  "𑁂",
+			"ai": "𑁃",
+			"o":  "𑁄",
+			"au": "𑁅",
+			"ṃ":  "𑀁",
+			"ḥ":  "𑀂",
+			"ã":  "𑀀",
+			"-":  "𑁆",
+		},
+		consonants: charMap{
+			"k":  "𑀓",
+			"kh": "𑀔",
+			"g":  "𑀕",
+			"gh": "𑀖",
+			"ṅ":  "𑀗",
+			"c":  "𑀘",
+			"ch": "𑀙",
+			"j":  "𑀚",
+			"jh": "𑀛",
+			"ñ":  "𑀜",
+			"ṭ":  "𑀝",
+			"ṭh": "𑀞",
+			"ḍ":  "𑀟",
+			"ḍh": "𑀠",
+			"ṇ":  "𑀡",
+			"t":  "𑀢",
+			"th": "𑀣",
+			"d":  "𑀤",
+			"dh": "𑀥",
+			"n":  "𑀦",
+			"p":  "𑀧",
+			"ph": "𑀨",
+			"b":  "𑀩",
+			"bh": "𑀪",
+			"m":  "𑀫",
+			"y":  "𑀬",
+			"r":  "𑀭",
+			"l":  "𑀮",
+			"v":  "𑀯",
+			"ś":  "𑀰",
+			"ṣ":  "𑀱",
+			"s":  "𑀲",
+			"h":  "𑀳",
+			"ḻ":  "𑀴",
+		},
+	},
+	shrd: {
+		misc: charMap{
+			"𑇅": ".",
+			"𑇆": "..",
+			"𑇁": "'",
+			"𑇄": "om",
+		},
+		numbers: charMap{
+			"0": "𑇐",
+			"1": "𑇑",
+			"2": "𑇒",
+			"3": "𑇓",
+			"4": "𑇔",
+			"5": "𑇕",
+			"6": "𑇖",
+			"7": "𑇗",
+			"8": "𑇘",
+			"9": "𑇙",
+		},
+		vowels: charMap{
+			"a":  "𑆃",
+			"ā":  "𑆄",
+			"i":  "𑆅",
+			"ī":  "𑆆",
+			"u":  "𑆇",
+			"ū":  "𑆈",
+			"ṛ":  "𑆉",
+			"ṝ":  "𑆊",
+			"ḷ":  "𑆋",
+			"ḹ":  "𑆌",
+			"e":  "𑆍",
+			"ai": "𑆎",
+			"o":  "𑆏",
+			"au": "𑆐",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "𑆳",
+			"i":  "𑆴",
+			"ī":  "𑆵",
+			"u":  "𑆶",
+			"ū":  "𑆷",
+			"ṛ":  "𑆸",
+			"ṝ":  "𑆹",
+			"ḷ":  "𑆺",
+			"ḹ":  "𑆻",
+			"e":  "𑆼",
+			"ai": "𑆽",
+			"o":  "𑆾",
+			"au": "𑆿",
+			"ṃ":  "𑆁",
+			"ḥ":  "𑆂",
+			"ã":  "𑆀",
+			"-":  "𑇀",
+		},
+		consonants: charMap{
+			"k":  "𑆑",
+			"kh": "𑆒",
+			"g":  "𑆓",
+			"gh": "𑆔",
+			"ṅ":  "𑆕",
+			"c":  "𑆖",
+			"ch": "𑆗",
+			"j":  "𑆘",
+			"jh": "𑆙",
+			"ñ":  "𑆚",
+			"ṭ":  "𑆛",
+			"ṭh": "𑆜",
+			"ḍ":  "𑆝",
+			"ḍh": "𑆞",
+			"ṇ":  "𑆟",
+			"t":  "𑆠",
+			"th": "𑆡",
+			"d":  "𑆢",
+			"dh": "𑆣",
+			"n":  "𑆤",
+			"p":  "𑆥",
+			"ph": "𑆦",
+			"b":  "𑆧",
+			"bh": "𑆨",
+			"m":  "𑆩",
+			"y":  "𑆪",
+			"r":  "𑆫",
+			"l":  "𑆬",
+			"v":  "𑆮",
+			"ś":  "𑆯",
+			"ṣ":  "𑆰",
+			"s":  "𑆱",
+			"h":  "𑆲",
+			"ḻ":  "𑆭",
+		},
+	},
+	// Siddham has no numerals, vowel signs for vocalic l or ḻ. Devanāgarī
+	// numerals are used, vocalic l follows a virāma and ḻ is written as l.
+	sidd: {
+		misc: charMap{
+			"𑗂":  ".",
+			"𑗃":  "..",
+			"ऽ":  "'",
+			"𑖌𑖽": "om",
+		},
+		numbers: charMap{
+			"0": "०",
+			"1": "१",
+			"2": "२",
+			"3": "३",
+			"4": "४",
+			"5": "५",
+			"6": "६",
+			"7": "७",
+			"8": "८",
+			"9": "९",
+		},
+		vowels: charMap{
+			"a":  "𑖀",
+			"ā":  "𑖁",
+			"i":  "𑖂",
+			"ī":  "𑖃",
+			"u":  "𑖄",
+			"ū":  "𑖅",
+			"ṛ":  "𑖆",
+			"ṝ":  "𑖇",
+			"ḷ":  "𑖈",
+			"ḹ":  "𑖉",
+			"e":  "𑖊",
+			"ai": "𑖋",
+			"o":  "𑖌",
+			"au": "𑖍",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "𑖯",
+			"i":  "𑖰",
+			"ī":  "𑖱",
+			"u":  "𑖲",
+			"ū":  "𑖳",
+			"ṛ":  "𑖴",
+			"ṝ":  "𑖵",
+			"ḷ":  "𑖿𑖈",
+			"ḹ":  "𑖿𑖉",
+			"e":  "𑖸",
+			"ai": "𑖹",
+			"o":  "𑖺",
+			"au": "𑖻",
+			"ṃ":  "𑖽",
+			"ḥ":  "𑖾",
+			"ã":  "𑖼",
+			"-":  "𑖿",
+		},
+		consonants: charMap{
+			"k":  "𑖎",
+			"kh": "𑖏",
+			"g":  "𑖐",
+			"gh": "𑖑",
+			"ṅ":  "𑖒",
+			"c":  "𑖓",
+			"ch": "𑖔",
+			"j":  "𑖕",
+			"jh": "𑖖",
+			"ñ":  "𑖗",
+			"ṭ":  "𑖘",
+			"ṭh": "𑖙",
+			"ḍ":  "𑖚",
+			"ḍh": "𑖛",
+			"ṇ":  "𑖜",
+			"t":  "𑖝",
+			"th": "𑖞",
+			"d":  "𑖟",
+			"dh": "𑖠",
+			"n":  "𑖡",
+			"p":  "𑖢",
+			"ph": "𑖣",
+			"b":  "𑖤",
+			"bh": "𑖥",
+			"m":  "𑖦",
+			"y":  "𑖧",
+			"r":  "𑖨",
+			"l":  "𑖩",
+			"v":  "𑖪",
+			"ś":  "𑖫",
+			"ṣ":  "𑖬",
+			"s":  "𑖭",
+			"h":  "𑖮",
+			"ḻ":  "𑖩",
+		},
+	},
+	// Newa has no ḻ, which is written as l
+	newa: {
+		misc: charMap{
+			"𑑋": ".",
+			"𑑌": "..",
+			"𑑇": "'",
+			"𑑉": "om",
+		},
+		numbers: charMap{
+			"0": "𑑐",
+			"1": "𑑑",
+			"2": "𑑒",
+			"3": "𑑓",
+			"4": "𑑔",
+			"5": "𑑕",
+			"6": "𑑖",
+			"7": "𑑗",
+			"8": "𑑘",
+			"9": "𑑙",
+		},
+		vowels: charMap{
+			"a":  "𑐀",
+			"ā":  "𑐁",
+			"i":  "𑐂",
+			"ī":  "𑐃",
+			"u":  "𑐄",
+			"ū":  "𑐅",
+			"ṛ":  "𑐆",
+			"ṝ":  "𑐇",
+			"ḷ":  "𑐈",
+			"ḹ":  "𑐉",
+			"e":  "𑐊",
+			"ai": "𑐋",
+			"o":  "𑐌",
+			"au": "𑐍",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "𑐵",
+			"i":  "𑐶",
+			"ī":  "𑐷",
+			"u":  "𑐸",
+			"ū":  "𑐹",
+			"ṛ":  "𑐺",
+			"ṝ":  "𑐻",
+			"ḷ":  "𑐼",
+			"ḹ":  "𑐽",
+			"e":  "𑐾",
+			"ai": "𑐿",
+			"o":  "𑑀",
+			"au": "𑑁",
+			"ṃ":  "𑑄",
+			"ḥ":  "𑑅",
+			"ã":  "𑑃",
+			"-":  "𑑂",
+		},
+		consonants: charMap{
+			"k":  "𑐎",
+			"kh": "𑐏",
+			"g":  "𑐐",
+			"gh": "𑐑",
+			"ṅ":  "𑐒",
+			"c":  "𑐔",
+			"ch": "𑐕",
+			"j":  "𑐖",
+			"jh": "𑐗",
+			"ñ":  "𑐘",
+			"ṭ":  "𑐚",
+			"ṭh": "𑐛",
+			"ḍ":  "𑐜",
+			"ḍh": "𑐝",
+			"ṇ":  "𑐞",
+			"t":  "𑐟",
+			"th": "𑐠",
+			"d":  "𑐡",
+			"dh": "𑐢",
+			"n":  "𑐣",
+			"p":  "𑐥",
+			"ph": "𑐦",
+			"b":  "𑐧",
+			"bh": "𑐨",
+			"m":  "𑐩",
+			"y":  "𑐫",
+			"r":  "𑐬",
+			"l":  "𑐮",
+			"v":  "𑐰",
+			"ś":  "𑐱",
+			"ṣ":  "𑐲",
+			"s":  "𑐳",
+			"h":  "𑐴",
+			"ḻ":  "𑐮",
+		},
+	},
+	gran: {
+		misc: charMap{
+			"।": ".",
+			"॥": "..",
+			"𑌽": "'",
+			"𑍐": "om",
+		},
+		numbers: charMap{
+			"0": "௦",
+			"1": "௧",
+			"2": "௨",
+			"3": "௩",
+			"4": "௪",
+			"5": "௫",
+			"6": "௬",
+			"7": "௭",
+			"8": "௮",
+			"9": "௯",
+		},
+		vowels: charMap{
+			"a":  "𑌅",
+			"ā":  "𑌆",
+			"i":  "𑌇",
+			"ī":  "𑌈",
+			"u":  "𑌉",
+			"ū":  "𑌊",
+			"ṛ":  "𑌋",
+			"ṝ":  "𑍠",
+			"ḷ":  "𑌌",
+			"ḹ":  "𑍡",
+			"e":  "𑌏",
+			"ai": "𑌐",
+			"o":  "𑌓",
+			"au": "𑌔",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "𑌾",
+			"i":  "𑌿",
+			"ī":  "𑍀",
+			"u":  "𑍁",
+			"ū":  "𑍂",
+			"ṛ":  "𑍃",
+			"ṝ":  "𑍄",
+			"ḷ":  "𑍢",
+			"ḹ":  "𑍣",
+			"e":  "𑍇",
+			"ai": "𑍈",
+			"o":  "𑍋",
+			"au": "𑍌",
+			"ṃ":  "𑌂",
+			"ḥ":  "𑌃",
+			"ã":  "𑌁",
+			"ẖ":  "ᳵ",
+			"ḫ":  "ᳶ",
+			"ṁ":  "ꣳ",
+			"-":  "𑍍",
+		},
+		consonants: charMap{
+			"k":  "𑌕",
+			"kh": "𑌖",
+			"g":  "𑌗",
+			"gh": "𑌘",
+			"ṅ":  "𑌙",
+			"c":  "𑌚",
+			"ch": "𑌛",
+			"j":  "𑌜",
+			"jh": "𑌝",
+			"ñ":  "𑌞",
+			"ṭ":  "𑌟",
+			"ṭh": "𑌠",
+			"ḍ":  "𑌡",
+			"ḍh": "𑌢",
+			"ṇ":  "𑌣",
+			"t":  "𑌤",
+			"th": "𑌥",
+			"d":  "𑌦",
+			"dh": "𑌧",
+			"n":  "𑌨",
+			"p":  "𑌪",
+			"ph": "𑌫",
+			"b":  "𑌬",
+			"bh": "𑌭",
+			"m":  "𑌮",
+			"y":  "𑌯",
+			"r":  "𑌰",
+			"l":  "𑌲",
+			"v":  "𑌵",
+			"ś":  "𑌶",
+			"ṣ":  "𑌷",
+			"s":  "𑌸",
+			"h":  "𑌹",
+			"ḻ":  "𑌳",
+		},
+	},
+	// Tamil letters stand for the unaspirated voiceless consonants, and the
+	// others add a superscript numeral, written after the vowel sign. Vocalic
+	// r and l are written as ru and lu marked with ʼ, and anusvāra as m.
+	taml: {
+		misc: charMap{
+			"।": ".",
+			"॥": "..",
+			"ऽ": "'",
+			"ௐ": "om",
+		},
+		numbers: charMap{
+			"0": "௦",
+			"1": "௧",
+			"2": "௨",
+			"3": "௩",
+			"4": "௪",
+			"5": "௫",
+			"6": "௬",
+			"7": "௭",
+			"8": "௮",
+			"9": "௯",
+		},
+		vowels: charMap{
+			"a":  "அ",
+			"ā":  "ஆ",
+			"i":  "இ",
+			"ī":  "ஈ",
+			"u":  "உ",
+			"ū":  "ஊ",
+			"ṛ":  "ருʼ",
+			"ṝ":  "ரூʼ",
+			"ḷ":  "லுʼ",
+			"ḹ":  "லூʼ",
+			"e":  "ஏ",
+			"ĕ":  "எ",
+			"ai": "ஐ",
+			"o":  "ஓ",
+			"ŏ":  "ஒ",
+			"au": "ஔ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "ா",
+			"i":  "ி",
+			"ī":  "ீ",
+			"u":  "ு",
+			"ū":  "ூ",
+			"ṛ":  "்ருʼ",
+			"ṝ":  "்ரூʼ",
+			"ḷ":  "்லுʼ",
+			"ḹ":  "்லூʼ",
+			"e":  "ே",
+			"ĕ":  "ெ",
+			"ai": "ை",
+			"o":  "ோ",
+			"ŏ":  "ொ",
+			"au": "ௌ",
+			"ṃ":  "ம்",
+			"ḥ":  "ஃ",
+			"ã":  "ம்",
+			"-":  "்",
+		},
+		consonants: charMap{
+			"k":  "க",
+			"kh": "க²",
+			"g":  "க³",
+			"gh": "க⁴",
+			"ṅ":  "ங",
+			"c":  "ச",
+			"ch": "ச²",
+			"j":  "ஜ",
+			"jh": "ஜ⁴",
+			"ñ":  "ஞ",
+			"ṭ":  "ட",
+			"ṭh": "ட²",
+			"ḍ":  "ட³",
+			"ḍh": "ட⁴",
+			"ṇ":  "ண",
+			"t":  "த",
+			"th": "த²",
+			"d":  "த³",
+			"dh": "த⁴",
+			"n":  "ந",
+			"p":  "ப",
+			"ph": "ப²",
+			"b":  "ப³",
+			"bh": "ப⁴",
+			"m":  "ம",
+			"y":  "ய",
+			"r":  "ர",
+			"l":  "ல",
+			"v":  "வ",
+			"ś":  "ஶ",
+			"ṣ":  "ஷ",
+			"s":  "ஸ",
+			"h":  "ஹ",
+			"ḻ":  "ள",
+		},
+	},
+	// Thai writes e, ai and o before their consonant, while the tables keep
+	// them after it, in spoken order. Vowels that begin a word are written on
+	// the letter o ang, and virāma is the phinthu.
+	thai: {
+		misc: charMap{
+			"ฯ":   ".",
+			"๚":   "..",
+			"ऽ":   "'",
+			"อโํ": "om",
+		},
+		numbers: charMap{
+			"0": "๐",
+			"1": "๑",
+			"2": "๒",
+			"3": "๓",
+			"4": "๔",
+			"5": "๕",
+			"6": "๖",
+			"7": "๗",
+			"8": "๘",
+			"9": "๙",
+		},
+		vowels: charMap{
+			"a":  "อ",
+			"ā":  "อา",
+			"i":  "อิ",
+			"ī":  "อี",
+			"u":  "อุ",
+			"ū":  "อู",
+			"ṛ":  "ฤ",
+			"ṝ":  "ฤๅ",
+			"ḷ":  "ฦ",
+			"ḹ":  "ฦๅ",
+			"e":  "อเ",
+			"ai": "อไ",
+			"o":  "อโ",
+			"au": "อเา",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "า",
+			"i":  "ิ",
+			"ī":  "ี",
+			"u":  "ุ",
+			"ū":  "ู",
+			"ṛ":  "ฺฤ",
+			"ṝ":  "ฺฤๅ",
+			"ḷ":  "ฺฦ",
+			"ḹ":  "ฺฦๅ",
+			"e":  "เ",
+			"ai": "ไ",
+			"o":  "โ",
+			"au": "เา",
+			"ṃ":  "ํ",
+			"ḥ":  "ะ",
+			"ã":  "ํ",
+			"-":  "ฺ",
+		},
+		consonants: charMap{
+			"k":  "ก",
+			"kh": "ข",
+			"g":  "ค",
+			"gh": "ฆ",
+			"ṅ":  "ง",
+			"c":  "จ",
+			"ch": "ฉ",
+			"j":  "ช",
+			"jh": "ฌ",
+			"ñ":  "ญ",
+			"ṭ":  "ฏ",
+			"ṭh": "ฐ",
+			"ḍ":  "ฑ",
+			"ḍh": "ฒ",
+			"ṇ":  "ณ",
+			"t":  "ต",
+			"th": "ถ",
+			"d":  "ท",
+			"dh": "ธ",
+			"n":  "น",
+			"p":  "ป",
+			"ph": "ผ",
+			"b":  "พ",
+			"bh": "ภ",
+			"m":  "ม",
+			"y":  "ย",
+			"r":  "ร",
+			"l":  "ล",
+			"v":  "ว",
+			"ś":  "ศ",
+			"ṣ":  "ษ",
+			"s":  "ส",
+			"h":  "ห",
+			"ḻ":  "ฬ",
+		},
+	},
+	// Khmer subjoins consonants with the coeng, which is written as a viriam
+	// when no consonant follows.
+	khmr: {
+		misc: charMap{
+			"។":  ".",
+			"៕":  "..",
+			"ऽ":  "'",
+			"ឱំ": "om",
+		},
+		numbers: charMap{
+			"0": "០",
+			"1": "១",
+			"2": "២",
+			"3": "៣",
+			"4": "៤",
+			"5": "៥",
+			"6": "៦",
+			"7": "៧",
+			"8": "៨",
+			"9": "៩",
+		},
+		vowels: charMap{
+			"a":  "អ",
+			"ā":  "អា",
+			"i":  "ឥ",
+			"ī":  "ឦ",
+			"u":  "ឧ",
+			"ū":  "ឩ",
+			"ṛ":  "ឫ",
+			"ṝ":  "ឬ",
+			"ḷ":  "ឭ",
+			"ḹ":  "ឮ",
+			"e":  "ឯ",
+			"ai": "ឰ",
+			"o":  "ឱ",
+			"au": "ឳ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "ា",
+			"i":  "ិ",
+			"ī":  "ី",
+			"u":  "ុ",
+			"ū":  "ូ",
+			"ṛ":  "្ឫ",
+			"ṝ":  "្ឬ",
+			"ḷ":  "្ឭ",
+			"ḹ":  "្ឮ",
+			"e":  "េ",
+			"ai": "ៃ",
+			"o":  "ោ",
+			"au": "ៅ",
+			"ṃ":  "ំ",
+			"ḥ":  "ះ",
+			"ã":  "ំ",
+			"-":  "្",
+		},
+		consonants: charMap{
+			"k":  "ក",
+			"kh": "ខ",
+			"g":  "គ",
+			"gh": "ឃ",
+			"ṅ":  "ង",
+			"c":  "ច",
+			"ch": "ឆ",
+			"j":  "ជ",
+			"jh": "ឈ",
+			"ñ":  "ញ",
+			"ṭ":  "ដ",
+			"ṭh": "ឋ",
+			"ḍ":  "ឌ",
+			"ḍh": "ឍ",
+			"ṇ":  "ណ",
+			"t":  "ត",
+			"th": "ថ",
+			"d":  "ទ",
+			"dh": "ធ",
+			"n":  "ន",
+			"p":  "ប",
+			"ph": "ផ",
+			"b":  "ព",
+			"bh": "ភ",
+			"m":  "ម",
+			"y":  "យ",
+			"r":  "រ",
+			"l":  "ល",
+			"v":  "វ",
+			"ś":  "ឝ",
+			"ṣ":  "ឞ",
+			"s":  "ស",
+			"h":  "ហ",
+			"ḻ":  "ឡ",
+		},
+	},
+	bali: {
+		misc: charMap{
+			"᭞":  ".",
+			"᭟":  "..",
+			"ऽ":  "'",
+			"ᬑᬂ": "om",
+		},
+		numbers: charMap{
+			"0": "᭐",
+			"1": "᭑",
+			"2": "᭒",
+			"3": "᭓",
+			"4": "᭔",
+			"5": "᭕",
+			"6": "᭖",
+			"7": "᭗",
+			"8": "᭘",
+			"9": "᭙",
+		},
+		vowels: charMap{
+			"a":  "ᬅ",
+			"ā":  "ᬆ",
+			"i":  "ᬇ",
+			"ī":  "ᬈ",
+			"u":  "ᬉ",
+			"ū":  "ᬊ",
+			"ṛ":  "ᬋ",
+			"ṝ":  "ᬌ",
+			"ḷ":  "ᬍ",
+			"ḹ":  "ᬎ",
+			"e":  "ᬏ",
+			"ai": "ᬐ",
+			"o":  "ᬑ",
+			"au": "ᬒ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "ᬵ",
+			"i":  "ᬶ",
+			"ī":  "ᬷ",
+			"u":  "ᬸ",
+			"ū":  "ᬹ",
+			"ṛ":  "ᬺ",
+			"ṝ":  "ᬻ",
+			"ḷ":  "ᬼ",
+			"ḹ":  "ᬽ",
+			"e":  "ᬾ",
+			"ai": "ᬿ",
+			"o":  "ᭀ",
+			"au": "ᭁ",
+			"ṃ":  "ᬂ",
+			"ḥ":  "ᬄ",
+			"ã":  "ᬁ",
+			"-":  "᭄",
+		},
+		consonants: charMap{
+			"k":  "ᬓ",
+			"kh": "ᬔ",
+			"g":  "ᬕ",
+			"gh": "ᬖ",
+			"ṅ":  "ᬗ",
+			"c":  "ᬘ",
+			"ch": "ᬙ",
+			"j":  "ᬚ",
+			"jh": "ᬛ",
+			"ñ":  "ᬜ",
+			"ṭ":  "ᬝ",
+			"ṭh": "ᬞ",
+			"ḍ":  "ᬟ",
+			"ḍh": "ᬠ",
+			"ṇ":  "ᬡ",
+			"t":  "ᬢ",
+			"th": "ᬣ",
+			"d":  "ᬤ",
+			"dh": "ᬥ",
+			"n":  "ᬦ",
+			"p":  "ᬧ",
+			"ph": "ᬨ",
+			"b":  "ᬩ",
+			"bh": "ᬪ",
+			"m":  "ᬫ",
+			"y":  "ᬬ",
+			"r":  "ᬭ",
+			"l":  "ᬮ",
+			"v":  "ᬯ",
+			"ś":  "ᬰ",
+			"ṣ":  "ᬱ",
+			"s":  "ᬲ",
+			"h":  "ᬳ",
+			"ḻ":  "ᬮ",
+		},
+	},
+	java: {
+		misc: charMap{
+			"꧈":  ".",
+			"꧉":  "..",
+			"ऽ":  "'",
+			"ꦎꦁ": "om",
+		},
+		numbers: charMap{
+			"0": "꧐",
+			"1": "꧑",
+			"2": "꧒",
+			"3": "꧓",
+			"4": "꧔",
+			"5": "꧕",
+			"6": "꧖",
+			"7": "꧗",
+			"8": "꧘",
+			"9": "꧙",
+		},
+		vowels: charMap{
+			"a":  "ꦄ",
+			"ā":  "ꦄꦴ",
+			"i":  "ꦆ",
+			"ī":  "ꦇ",
+			"u":  "ꦈ",
+			"ū":  "ꦈꦴ",
+			"ṛ":  "ꦉ",
+			"ṝ":  "ꦉꦴ",
+			"ḷ":  "ꦊ",
+			"ḹ":  "ꦋ",
+			"e":  "ꦌ",
+			"ai": "ꦍ",
+			"o":  "ꦎ",
+			"au": "ꦎꦴ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "ꦴ",
+			"i":  "ꦶ",
+			"ī":  "ꦷ",
+			"u":  "ꦸ",
+			"ū":  "ꦹ",
+			"ṛ":  "ꦽ",
+			"ṝ":  "ꦽꦴ",
+			"ḷ":  "꧀ꦊ",
+			"ḹ":  "꧀ꦋ",
+			"e":  "ꦺ",
+			"ai": "ꦻ",
+			"o":  "ꦺꦴ",
+			"au": "ꦻꦴ",
+			"ṃ":  "ꦁ",
+			"ḥ":  "ꦃ",
+			"ã":  "ꦀ",
+			"-":  "꧀",
+		},
+		consonants: charMap{
+			"k":  "ꦏ",
+			"kh": "ꦑ",
+			"g":  "ꦒ",
+			"gh": "ꦓ",
+			"ṅ":  "ꦔ",
+			"c":  "ꦕ",
+			"ch": "ꦖ",
+			"j":  "ꦗ",
+			"jh": "ꦙ",
+			"ñ":  "ꦚ",
+			"ṭ":  "ꦛ",
+			"ṭh": "ꦜ",
+			"ḍ":  "ꦝ",
+			"ḍh": "ꦞ",
+			"ṇ":  "ꦟ",
+			"t":  "ꦠ",
+			"th": "ꦡ",
+			"d":  "ꦢ",
+			"dh": "ꦣ",
+			"n":  "ꦤ",
+			"p":  "ꦥ",
+			"ph": "ꦦ",
+			"b":  "ꦧ",
+			"bh": "ꦨ",
+			"m":  "ꦩ",
+			"y":  "ꦪ",
+			"r":  "ꦫ",
+			"l":  "ꦭ",
+			"v":  "ꦮ",
+			"ś":  "ꦯ",
+			"ṣ":  "ꦰ",
+			"s":  "ꦱ",
+			"h":  "ꦲ",
+			"ḻ":  "ꦭ",
+		},
+	},
+	// Burmese stacks consonants, but writes y, r, v and h after a consonant as
+	// medials, and a virāma that ends a word as an asat.
+	mymr: {
+		misc: charMap{
+			"၊":   ".",
+			"။":   "..",
+			"ऽ":   "'",
+			"ဥုံ": "om",
+		},
+		numbers: charMap{
+			"0": "၀",
+			"1": "၁",
+			"2": "၂",
+			"3": "၃",
+			"4": "၄",
+			"5": "၅",
+			"6": "၆",
+			"7": "၇",
+			"8": "၈",
+			"9": "၉",
+		},
+		vowels: charMap{
+			"a":  "အ",
+			"ā":  "အာ",
+			"i":  "ဣ",
+			"ī":  "ဤ",
+			"u":  "ဥ",
+			"ū":  "ဦ",
+			"ṛ":  "ၒ",
+			"ṝ":  "ၓ",
+			"ḷ":  "ၔ",
+			"ḹ":  "ၕ",
+			"e":  "ဧ",
+			"ai": "အဲ",
+			"o":  "ဩ",
+			"au": "ဪ",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "ာ",
+			"i":  "ိ",
+			"ī":  "ီ",
+			"u":  "ု",
+			"ū":  "ူ",
+			"ṛ":  "ၖ",
+			"ṝ":  "ၗ",
+			"ḷ":  "ၘ",
+			"ḹ":  "ၙ",
+			"e":  "ေ",
+			"ai": "ဲ",
+			"o":  "ော",
+			"au": "ော်",
+			"ṃ":  "ံ",
+			"ḥ":  "း",
+			"ã":  "ံ",
+			"-":  "္",
+		},
+		consonants: charMap{
+			"k":  "က",
+			"kh": "ခ",
+			"g":  "ဂ",
+			"gh": "ဃ",
+			"ṅ":  "င",
+			"c":  "စ",
+			"ch": "ဆ",
+			"j":  "ဇ",
+			"jh": "ဈ",
+			"ñ":  "ဉ",
+			"ṭ":  "ဋ",
+			"ṭh": "ဌ",
+			"ḍ":  "ဍ",
+			"ḍh": "ဎ",
+			"ṇ":  "ဏ",
+			"t":  "တ",
+			"th": "ထ",
+			"d":  "ဒ",
+			"dh": "ဓ",
+			"n":  "န",
+			"p":  "ပ",
+			"ph": "ဖ",
+			"b":  "ဗ",
+			"bh": "ဘ",
+			"m":  "မ",
+			"y":  "ယ",
+			"r":  "ရ",
+			"l":  "လ",
+			"v":  "ဝ",
+			"ś":  "ၐ",
+			"ṣ":  "ၑ",
+			"s":  "သ",
+			"h":  "ဟ",
+			"ḻ":  "ဠ",
+		},
+	},
+	// Bharati Braille uses the same cell for a vowel and its sign, writes each
+	// digit after the number sign, and has no avagraha
+	braille: {
+		misc: charMap{
+			"⠲":  ".",
+			"⠲⠲": "..",
+			"⠕⠰": "om",
+		},
+		numbers: charMap{
+			"0": "⠼⠚",
+			"1": "⠼⠁",
+			"2": "⠼⠃",
+			"3": "⠼⠉",
+			"4": "⠼⠙",
+			"5": "⠼⠑",
+			"6": "⠼⠋",
+			"7": "⠼⠛",
+			"8": "⠼⠓",
+			"9": "⠼⠊",
+		},
+		vowels: charMap{
+			"a":  "⠁",
+			"ā":  "⠜",
+			"i":  "⠊",
+			"ī":  "⠔",
+			"u":  "⠥",
+			"ū":  "⠳",
+			"ṛ":  "⠐⠗",
+			"ṝ":  "⠠⠗",
+			"ḷ":  "⠐⠇",
+			"ḹ":  "⠠⠇",
+			"e":  "⠑",
+			"ĕ":  "⠢",
+			"ai": "⠌",
+			"o":  "⠕",
+			"ŏ":  "⠭",
+			"au": "⠪",
+		},
+		vowelSigns: charMap{
+			"a":  "",
+			"ā":  "⠜",
+			"i":  "⠊",
+			"ī":  "⠔",
+			"u":  "⠥",
+			"ū":  "⠳",
+			"ṛ":  "⠐⠗",
+			"ṝ":  "⠠⠗",
+			"ḷ":  "⠐⠇",
+			"ḹ":  "⠠⠇",
+			"e":  "⠑",
+			"ĕ":  "⠢",
+			"ai": "⠌",
+			"o":  "⠕",
+			"ŏ":  "⠭",
+			"au": "⠪",
+			"ṃ":  "⠰",
+			"ḥ":  "⠠",
+			"ã":  "⠄",
+			"-":  "⠈",
+		},
+		consonants: charMap{
+			"k":  "⠅",
+			"kh": "⠨",
+			"g":  "⠛",
+			"gh": "⠣",
+			"ṅ":  "⠬",
+			"c":  "⠉",
+			"ch": "⠡",
+			"j":  "⠚",
+			"jh": "⠴",
+			"ñ":  "⠒",
+			"ṭ":  "⠾",
+			"ṭh": "⠺",
+			"ḍ":  "⠫",
+			"ḍh": "⠿",
+			"ṇ":  "⠼",
+			"t":  "⠞",
+			"th": "⠹",
+			"d":  "⠙",
+			"dh": "⠮",
+			"n":  "⠝",
+			"p":  "⠏",
+			"ph": "⠖",
+			"b":  "⠃",
+			"bh": "⠘",
+			"m":  "⠍",
+			"y":  "⠽",
+			"r":  "⠗",
+			"l":  "⠇",
+			"v":  "⠧",
+			"ś":  "⠩",
+			"ṣ":  "⠯",
+			"s":  "⠎",
+			"h":  "⠓",
+			"ḻ":  "⠸",
+		},
+	},
 	sa: {
 		misc: charMap{
 			"।": ".",
@@ -618,8 +2170,10 @@ var charDict = map[langList]langMap{
 			"ḷ":  "ऌ",
 			"ḹ":  "ॡ",
 			"e":  "ए",
+			"ĕ":  "ऎ",
 			"ai": "ऐ",
 			"o":  "ओ",
+			"ŏ":  "ऒ",
 			"au": "औ",
 		},
 		vowelSigns: charMap{
@@ -634,12 +2188,17 @@ var charDict = map[langList]langMap{
 			"ḷ":  "ॢ",
 			"ḹ":  "ॣ",
 			"e":  "े",
+			"ĕ":  "ॆ",
 			"ai": "ै",
 			"o":  "ो",
+			"ŏ":  "ॊ",
 			"au": "ौ",
 			"ṃ":  "ं",
 			"ḥ":  "ः",
 			"ã":  "ँ",
+			"ẖ":  "ᳵ",
+			"ḫ":  "ᳶ",
+			"ṁ":  "ꣳ",
 			"-":  "्",
 		},
 		consonants: charMap{
@@ -702,6 +2261,12 @@ var unicodeMap = charMap{
 	".":  "।",
 	"..": "॥",
 	"au": "ã",
+	"es": "ĕ",
+	"os": "ŏ",
+	"hl": "ẖ",
+	"hb": "ḫ",
+	"mu": "ṁ",
+	"''": "\u030b",
 }
 
 var devanāgarīDataDict = charMap{
@@ -750,8 +2315,10 @@ var devanāgarīDataDict = charMap{
 	"ऌ": "/l/",
 	"ॡ": "/lu/",
 	"ए": "e",
+	"ऎ": "/es/",
 	"ऐ": "ai",
 	"ओ": "o",
+	"ऒ": "/os/",
 	"औ": "au",
 	"":  "a",
 	"ा": "/a/",
@@ -764,12 +2331,17 @@ var devanāgarīDataDict = charMap{
 	"ॢ": "/l/",
 	"ॣ": "/lu/",
 	"े": "e",
+	"ॆ": "/es/",
 	"ै": "ai",
 	"ो": "o",
+	"ॊ": "/os/",
 	"ौ": "au",
 	"ं": "/m/",
 	"ः": "/h/",
 	"ँ": "/au/",
+	"ᳵ": "/hl/",
+	"ᳶ": "/hb/",
+	"ꣳ": "/mu/",
 	"्": "-",
 	"ऽ": "\\/'/\\",
 	"।": "\\/./\\",
@@ -818,9 +2390,137 @@ var iastDataDict = charMap{
 	"ऽ": "'",
 	"।": ".",
 	"॥": "..",
+	"ॐ": "om",
 	"ã": "au",
+	"ĕ": "es",
+	"ŏ": "os",
+	"ẖ": "hl",
+	"ḫ": "hb",
+	"ṁ": "mu",
+}
+
+// The tone marks and other signs of the Vedic Extensions block, such as the
+// triple svarita ᳛, the karṣaṇa ᳐ of the Sāmaveda, the anusvāra signs ᳩ–ᳱ
+// and the ardhavisarga ᳲ, but not jihvāmūlīya and upadhmānīya. No
+// romanisation has a spelling for them, so every scheme writes them as they
+// are.
+var vedicMarks = func() []string {
+	var ans []string
+
+	for r := rune(0x1cd0); r <= 0x1cf9; r++ {
+		if r != '᳚' && r != 'ᳵ' && r != 'ᳶ' && unicode.In(r, unicode.Mn, unicode.Mc, unicode.Po, unicode.Lo) {
+			ans = append(ans, string(r))
+		}
+	}
+
+	return ans
+}()
+
+// Add the Vedic marks to a table of accents, each written as itself
+func withVedicMarks(m charMap) charMap {
+	for _, v := range vedicMarks {
+		m[v] = v
+	}
+
+	return m
+}
+
+// Vedic accents in parsed UAST, and the devanāgarī marks for them, which the
+// other scripts share
+var accentDict = withVedicMarks(charMap{
+	"'":      "॑",
+	"`":      "॒",
+	"\u030b": "᳚",
+})
+
+// IAST combining marks of the accents
+var iastAccentDict = withVedicMarks(charMap{
+	"'":      "\u0301",
+	"`":      "\u0300",
+	"\u030b": "\u030b",
+})
+
+// Accents as written in UAST
+var uastAccentDict = withVedicMarks(charMap{
+	"\u0301": "\\'",
+	"\u0300": "\\`",
+	"\u030b": "\\/''/",
+})
+
+var devanāgarīAccentDict = reverseCharMap(accentDict)
+
+// Vowels of IAST and the other romanisations that NFC composes with an
+// accent
+var accentReplacer = strings.NewReplacer(
+	"á", "a\u0301",
+	"à", "a\u0300",
+	"é", "e\u0301",
+	"è", "e\u0300",
+	"í", "i\u0301",
+	"ì", "i\u0300",
+	"ó", "o\u0301",
+	"ò", "o\u0300",
+	"ő", "o\u030b",
+	"ú", "u\u0301",
+	"ù", "u\u0300",
+	"ű", "u\u030b",
+	"Á", "A\u0301",
+	"À", "A\u0300",
+	"É", "E\u0301",
+	"È", "E\u0300",
+	"Í", "I\u0301",
+	"Ì", "I\u0300",
+	"Ó", "O\u0301",
+	"Ò", "O\u0300",
+	"Ő", "O\u030b",
+	"Ú", "U\u0301",
+	"Ù", "U\u0300",
+	"Ű", "U\u030b",
+	"ḗ", "ē\u0301",
+	"ḕ", "ē\u0300",
+	"ṓ", "ō\u0301",
+	"ṑ", "ō\u0300",
+	"Ḗ", "Ē\u0301",
+	"Ḕ", "Ē\u0300",
+	"Ṓ", "Ō\u0301",
+	"Ṑ", "Ō\u0300",
+	// NFC writes the acute of ISO r̥ and l̥ on the r and l, before the ring
+	"ŕ\u0325", "r\u0325\u0301",
+	"ĺ\u0325", "l\u0325\u0301",
+	"Ŕ\u0325", "R\u0325\u0301",
+	"Ĺ\u0325", "L\u0325\u0301",
+	"ŕ", "r\u0301",
+	"ĺ", "l\u0301",
+	"Ŕ", "R\u0301",
+	"Ĺ", "L\u0301",
+)
+
+// Accents and accented vowels accepted in IAST
+var iastAccents = append(
+	slices.Collect(maps.Values(iastAccentDict)),
+	"á", "à", "é", "è", "í", "ì", "ó", "ò", "ő", "ú", "ù", "ű",
+	"Á", "À", "É", "È", "Í", "Ì", "Ó", "Ò", "Ő", "Ú", "Ù", "Ű",
+)
+
+var diphthongAccent = regexp.MustCompile("a([\u0300\u0301\u030b])([iu])")
+
+// Split accented IAST vowels, and move the accent of ai and au, written on
+// the a, after the diphthong
+func splitAccents(s string) string {
+	return diphthongAccent.ReplaceAllString(accentReplacer.Replace(s), "a$2$1")
 }
 
+// IAST writes the Vedic anusvāra ꣳ as gͫ, as most texts use ṁ for the common
+// anusvāra. The tables spell it ṁ, so IAST is read with iastVedicIn, which
+// keeps the length of the text, and written with iastVedicOut.
+var (
+	iastVedicIn  = strings.NewReplacer("ṁ", "ṃ", "gͫ", "ṁ")
+	iastVedicOut = strings.NewReplacer("ṁ", "gͫ")
+)
+
+// Signs that follow a vowel
+var ayogavāhas = []string{"ḥ", "ṃ", "ã", "ẖ", "ḫ", "ṁ"}
+
 var unAspiratedConsonants = []string{
 	"b",
 	"c",
@@ -831,6 +2531,7 @@ var unAspiratedConsonants = []string{
 	"p",
 	"t",
 	"ḍ",
+	"ḻ",
 	"ṭ",
 }
 
@@ -901,6 +2602,392 @@ var slpDataDict = charMap{
 	"h": "h",
 	"'": "'",
 	"~": "ã",
+	"Z": "ẖ",
+	"V": "ḫ",
+
+	// Vedic accents
+	"/":      "\u0301",
+	"\\":     "\u0300",
+	"\u030b": "\u030b",
+}
+
+var hkDataDict = charMap{
+	"a":   "a",
+	"A":   "ā",
+	"i":   "i",
+	"I":   "ī",
+	"u":   "u",
+	"U":   "ū",
+	"e":   "e",
+	"ai":  "ai",
+	"o":   "o",
+	"au":  "au",
+	"R":   "ṛ",
+	"RR":  "ṝ",
+	"lR":  "ḷ",
+	"lRR": "ḹ",
+	"L":   "ḻ",
+	"k":   "k",
+	"kh":  "kh",
+	"g":   "g",
+	"gh":  "gh",
+	"G":   "ṅ",
+	"c":   "c",
+	"ch":  "ch",
+	"j":   "j",
+	"jh":  "jh",
+	"J":   "ñ",
+	"T":   "ṭ",
+	"Th":  "ṭh",
+	"D":   "ḍ",
+	"Dh":  "ḍh",
+	"N":   "ṇ",
+	"t":   "t",
+	"th":  "th",
+	"d":   "d",
+	"dh":  "dh",
+	"n":   "n",
+	"p":   "p",
+	"ph":  "ph",
+	"b":   "b",
+	"bh":  "bh",
+	"m":   "m",
+	"M":   "ṃ",
+	"H":   "ḥ",
+	"y":   "y",
+	"r":   "r",
+	"l":   "l",
+	"v":   "v",
+	"z":   "ś",
+	"S":   "ṣ",
+	"s":   "s",
+	"h":   "h",
+	"'":   "'",
+	"~":   "ã",
+	".":   ".",
+
+	// Vedic accents
+	"\u0301": "\u0301",
+	"\u0300": "\u0300",
+	"\u030b": "\u030b",
+}
+
+// Canonical ITRANS spellings, used for output
+var itransDataDict = charMap{
+	"a":   "a",
+	"aa":  "ā",
+	"i":   "i",
+	"ii":  "ī",
+	"u":   "u",
+	"uu":  "ū",
+	"e":   "e",
+	"ai":  "ai",
+	"o":   "o",
+	"au":  "au",
+	"RRi": "ṛ",
+	"RRI": "ṝ",
+	"LLi": "ḷ",
+	"LLI": "ḹ",
+	"L":   "ḻ",
+	"k":   "k",
+	"kh":  "kh",
+	"g":   "g",
+	"gh":  "gh",
+	"~N":  "ṅ",
+	"ch":  "c",
+	"Ch":  "ch",
+	"j":   "j",
+	"jh":  "jh",
+	"~n":  "ñ",
+	"T":   "ṭ",
+	"Th":  "ṭh",
+	"D":   "ḍ",
+	"Dh":  "ḍh",
+	"N":   "ṇ",
+	"t":   "t",
+	"th":  "th",
+	"d":   "d",
+	"dh":  "dh",
+	"n":   "n",
+	"p":   "p",
+	"ph":  "ph",
+	"b":   "b",
+	"bh":  "bh",
+	"m":   "m",
+	"M":   "ṃ",
+	"H":   "ḥ",
+	".N":  "ã",
+	"y":   "y",
+	"r":   "r",
+	"l":   "l",
+	"v":   "v",
+	"sh":  "ś",
+	"Sh":  "ṣ",
+	"s":   "s",
+	"h":   "h",
+	".a":  "'",
+	"OM":  "ॐ",
+	".":   ".",
+
+	// Vedic accents
+	"\\'":    "\u0301",
+	"\\_":    "\u0300",
+	"\u030b": "\u030b",
+}
+
+// Alternative ITRANS spellings, accepted on input
+var itransAliasDict = charMap{
+	"A":   "ā",
+	"I":   "ī",
+	"ee":  "ī",
+	"U":   "ū",
+	"oo":  "ū",
+	"R^i": "ṛ",
+	"R^I": "ṝ",
+	"L^i": "ḷ",
+	"L^I": "ḹ",
+	"ld":  "ḻ",
+	"N^":  "ṅ",
+	"c":   "c",
+	"chh": "ch",
+	"JN":  "ñ",
+	"w":   "v",
+	"shh": "ṣ",
+	"x":   "kṣ",
+	"kSh": "kṣ",
+	"GY":  "jñ",
+	"dny": "jñ",
+	".m":  "ṃ",
+	".n":  "ṃ",
+	".h":  "",
+	"AUM": "ॐ",
+	"|":   ".",
+	"||":  "..",
+}
+
+// Canonical Velthuis spellings, used for output
+var velthuisDataDict = charMap{
+	"a":   "a",
+	"aa":  "ā",
+	"i":   "i",
+	"ii":  "ī",
+	"u":   "u",
+	"uu":  "ū",
+	"e":   "e",
+	"ai":  "ai",
+	"o":   "o",
+	"au":  "au",
+	".r":  "ṛ",
+	".rr": "ṝ",
+	".l":  "ḷ",
+	".ll": "ḹ",
+	"L":   "ḻ",
+	"k":   "k",
+	"kh":  "kh",
+	"g":   "g",
+	"gh":  "gh",
+	"\"n": "ṅ",
+	"c":   "c",
+	"ch":  "ch",
+	"j":   "j",
+	"jh":  "jh",
+	"~n":  "ñ",
+	".t":  "ṭ",
+	".th": "ṭh",
+	".d":  "ḍ",
+	".dh": "ḍh",
+	".n":  "ṇ",
+	"t":   "t",
+	"th":  "th",
+	"d":   "d",
+	"dh":  "dh",
+	"n":   "n",
+	"p":   "p",
+	"ph":  "ph",
+	"b":   "b",
+	"bh":  "bh",
+	"m":   "m",
+	".m":  "ṃ",
+	".h":  "ḥ",
+	"/":   "ã",
+	"y":   "y",
+	"r":   "r",
+	"l":   "l",
+	"v":   "v",
+	"\"s": "ś",
+	".s":  "ṣ",
+	"s":   "s",
+	"h":   "h",
+	".a":  "'",
+	"|":   ".",
+	"||":  "..",
+
+	// Vedic accents
+	"\u0301": "\u0301",
+	"\u0300": "\u0300",
+	"\u030b": "\u030b",
+}
+
+// Alternative Velthuis spellings, accepted on input
+var velthuisAliasDict = charMap{
+	"A": "ā",
+	"I": "ī",
+	"U": "ū",
+	"&": "",
+}
+
+var wxDataDict = charMap{
+	"a": "a",
+	"A": "ā",
+	"i": "i",
+	"I": "ī",
+	"u": "u",
+	"U": "ū",
+	"q": "ṛ",
+	"Q": "ṝ",
+	"L": "ḷ",
+	"e": "e",
+	"E": "ai",
+	"o": "o",
+	"O": "au",
+	"M": "ṃ",
+	"H": "ḥ",
+	"z": "ã",
+	"k": "k",
+	"K": "kh",
+	"g": "g",
+	"G": "gh",
+	"f": "ṅ",
+	"c": "c",
+	"C": "ch",
+	"j": "j",
+	"J": "jh",
+	"F": "ñ",
+	"t": "ṭ",
+	"T": "ṭh",
+	"d": "ḍ",
+	"D": "ḍh",
+	"N": "ṇ",
+	"w": "t",
+	"W": "th",
+	"x": "d",
+	"X": "dh",
+	"n": "n",
+	"p": "p",
+	"P": "ph",
+	"b": "b",
+	"B": "bh",
+	"m": "m",
+	"y": "y",
+	"r": "r",
+	"l": "l",
+	"v": "v",
+	"S": "ś",
+	"R": "ṣ",
+	"s": "s",
+	"h": "h",
+	"'": "'",
+	".": ".",
+
+	// WX has no letter for ḻ
+	"lY": "ḻ",
+
+	// Vedic accents
+	"\u0301": "\u0301",
+	"\u0300": "\u0300",
+	"\u030b": "\u030b",
+}
+
+// ISO 15919 letters that differ from IAST. Combining marks are in NFC order.
+var isoDataDict = charMap{
+	"ṁ":             "ṃ",
+	"r\u0325":       "ṛ",
+	"r\u0325\u0304": "ṝ",
+	"l\u0325":       "ḷ",
+	"l\u0325\u0304": "ḹ",
+	"ḷ":             "ḻ",
+	"ē":             "e",
+	"ō":             "o",
+	"e":             "ĕ",
+	"o":             "ŏ",
+	"m\u0310":       "ã",
+}
+
+// Standard Cyrillic transliteration of Saṃskṛta. Combining marks are in NFC
+// order.
+var cyrlDataDict = charMap{
+	"а":             "a",
+	"а\u0304":       "ā",
+	"и":             "i",
+	"ӣ":             "ī",
+	"у":             "u",
+	"ӯ":             "ū",
+	"р\u0323":       "ṛ",
+	"р\u0323\u0304": "ṝ",
+	"л\u0323":       "ḷ",
+	"л\u0323\u0304": "ḹ",
+	"е":             "e",
+	"ӗ":             "ĕ",
+	"аи":            "ai",
+	"о":             "o",
+	"о\u0306":       "ŏ",
+	"ау":            "au",
+	"м\u0323":       "ṃ",
+	"х\u0323":       "ḥ",
+	"м\u0310":       "ã",
+	"к":             "k",
+	"кх":            "kh",
+	"г":             "g",
+	"гх":            "gh",
+	"н\u0307":       "ṅ",
+	"ч":             "c",
+	"чх":            "ch",
+	"дж":            "j",
+	"джх":           "jh",
+	"н\u0303":       "ñ",
+	"т\u0323":       "ṭ",
+	"т\u0323х":      "ṭh",
+	"д\u0323":       "ḍ",
+	"д\u0323х":      "ḍh",
+	"н\u0323":       "ṇ",
+	"т":             "t",
+	"тх":            "th",
+	"д":             "d",
+	"дх":            "dh",
+	"н":             "n",
+	"п":             "p",
+	"пх":            "ph",
+	"б":             "b",
+	"бх":            "bh",
+	"м":             "m",
+	"й":             "y",
+	"р":             "r",
+	"л":             "l",
+	"в":             "v",
+	"ш\u0301":       "ś",
+	"ш":             "ṣ",
+	"с":             "s",
+	"х":             "h",
+	"л\u0331":       "ḻ",
+	"'":             "'",
+	".":             ".",
+	"0":             "0",
+	"1":             "1",
+	"2":             "2",
+	"3":             "3",
+	"4":             "4",
+	"5":             "5",
+	"6":             "6",
+	"7":             "7",
+	"8":             "8",
+	"9":             "9",
+
+	// Vedic accents
+	"\u0301": "\u0301",
+	"\u0300": "\u0300",
+	"\u030b": "\u030b",
+	"ѐ":      "e\u0300",
+	"ѝ":      "i\u0300",
 }
 
 var iastAllowed = []string{
@@ -939,7 +3026,9 @@ var iastAllowed = []string{
 	"ã",
 	"ñ",
 	"ā",
+	"ĕ",
 	"ī",
+	"ŏ",
 	"ś",
 	"ū",
 	"ऽ",
@@ -959,9 +3048,12 @@ var iastAllowed = []string{
 	"ḍ",
 	"ḍh",
 	"ḥ",
+	"ḫ",
 	"ḷ",
 	"ḹ",
 	"ḻ",
+	"ḻh",
+	"ṁ",
 	"ṃ",
 	"ṅ",
 	"ṇ",
@@ -970,6 +3062,7 @@ var iastAllowed = []string{
 	"ṣ",
 	"ṭ",
 	"ṭh",
+	"ẖ",
 }
 
 // Function to map special characters to Unicode
@@ -978,65 +3071,8 @@ func createHandleUnicode(lang langList) func(string) string {
 
 	maps.Copy(langDict, unicodeMap)
 
-	switch lang {
-	case gu:
-		maps.Copy(
-			langDict,
-			charMap{
-				"om": "ૐ",
-				"'":  "ઽ",
-			},
-		)
-	case sa:
-		maps.Copy(
-			langDict,
-			charMap{
-				"om": "ॐ",
-				"'":  "ऽ",
-			},
-		)
-	case or:
-		maps.Copy(
-			langDict,
-			charMap{
-				"om": "ଓଁ",
-				"'":  "ଽ",
-			},
-		)
-	case kn:
-		maps.Copy(
-			langDict,
-			charMap{
-				"om": "ಓಂ",
-				"'":  "ಽ",
-			},
-		)
-	case te:
-		maps.Copy(
-			langDict,
-			charMap{
-				"'":  "ఽ",
-				"om": "ఓం",
-			},
-		)
-	case ml:
-		maps.Copy(
-			langDict,
-			charMap{
-				"'":  "ഽ",
-				"om": "ഓം",
-			},
-		)
-	case ta:
-		maps.Copy(
-			langDict,
-			charMap{
-				"'":  "𑌽",
-				"om": "𑍐",
-			},
-		)
-	default:
-		panic("Unhandled case")
+	for k, v := range charDict[lang].misc {
+		langDict[v] = k
 	}
 
 	return func(uast string) string {
@@ -1489,7 +3525,736 @@ var devanāgarīScriptDict = map[langList]charMap{
 		"ഹ":  "ह",
 		"ള":  "ळ",
 	},
-	ta: {
+	ta: {
+		"।": "।",
+		"॥": "॥",
+		"𑌽": "ऽ",
+		"𑍐": "ॐ",
+		"௦": "०",
+		"௧": "१",
+		"௨": "२",
+		"௩": "३",
+		"௪": "४",
+		"௫": "५",
+		"௬": "६",
+		"௭": "७",
+		"௮": "८",
+		"௯": "९",
+		"𑌅": "अ",
+		"𑌆": "आ",
+		"𑌇": "इ",
+		"𑌈": "ई",
+		"𑌉": "उ",
+		"𑌊": "ऊ",
+		"𑌋": "ऋ",
+		"𑍠": "ॠ",
+		"𑌌": "ऌ",
+		"𑍡": "ॡ",
+		"𑌏": "ए",
+		"𑌐": "ऐ",
+		"𑌓": "ओ",
+		"𑌔": "औ",
+		"𑌾": "ा",
+		"𑌿": "ि",
+		"𑍀": "ी",
+		"𑍁": "ु",
+		"𑍂": "ू",
+		"𑍃": "ृ",
+		"𑍄": "ॄ",
+		"𑍢": "ॢ",
+		"𑍣": "ॣ",
+		"𑍇": "े",
+		"𑍈": "ै",
+		"𑍋": "ो",
+		"𑍗": "ौ",
+		"𑌂": "ं",
+		"𑌃": "ः",
+		"𑌁": "ँ",
+		"ᳵ": "ᳵ",
+		"ᳶ": "ᳶ",
+		"ꣳ": "ꣳ",
+		"𑍍": "्",
+		"𑌕": "क",
+		"𑌖": "ख",
+		"𑌗": "ग",
+		"𑌘": "घ",
+		"𑌙": "ङ",
+		"𑌚": "च",
+		"𑌛": "छ",
+		"𑌜": "ज",
+		"𑌝": "झ",
+		"𑌞": "ञ",
+		"𑌟": "ट",
+		"𑌠": "ठ",
+		"𑌡": "ड",
+		"𑌢": "ढ",
+		"𑌣": "ण",
+		"𑌤": "त",
+		"𑌥": "थ",
+		"𑌦": "द",
+		"𑌧": "ध",
+		"𑌨": "न",
+		"𑌪": "प",
+		"𑌫": "फ",
+		"𑌬": "ब",
+		"𑌭": "भ",
+		"𑌮": "म",
+		"𑌯": "य",
+		"𑌰": "र",
+		"𑌲": "ल",
+		"𑌵": "व",
+		"𑌶": "श",
+		"𑌷": "ष",
+		"𑌸": "स",
+		"𑌹": "ह",
+		"𑌳": "ळ",
+	},
+	bn: {
+		"।":  "।",
+		"॥":  "॥",
+		"ঽ":  "ऽ",
+		"ওঁ": "ॐ",
+		"০":  "०",
+		"১":  "१",
+		"২":  "२",
+		"৩":  "३",
+		"৪":  "४",
+		"৫":  "५",
+		"৬":  "६",
+		"৭":  "७",
+		"৮":  "८",
+		"৯":  "९",
+		"অ":  "अ",
+		"আ":  "आ",
+		"ই":  "इ",
+		"ঈ":  "ई",
+		"উ":  "उ",
+		"ঊ":  "ऊ",
+		"ঋ":  "ऋ",
+		"ৠ":  "ॠ",
+		"ঌ":  "ऌ",
+		"ৡ":  "ॡ",
+		"এ":  "ए",
+		"ঐ":  "ऐ",
+		"ও":  "ओ",
+		"ঔ":  "औ",
+		"া":  "ा",
+		"ি":  "ि",
+		"ী":  "ी",
+		"ু":  "ु",
+		"ূ":  "ू",
+		"ৃ":  "ृ",
+		"ৄ":  "ॄ",
+		"ৢ":  "ॢ",
+		"ৣ":  "ॣ",
+		"ে":  "े",
+		"ৈ":  "ै",
+		"ো":  "ो",
+		"ৌ":  "ौ",
+		"ং":  "ं",
+		"ঃ":  "ः",
+		"ঁ":  "ँ",
+		"্":  "्",
+		"ক":  "क",
+		"খ":  "ख",
+		"গ":  "ग",
+		"ঘ":  "घ",
+		"ঙ":  "ङ",
+		"চ":  "च",
+		"ছ":  "छ",
+		"জ":  "ज",
+		"ঝ":  "झ",
+		"ঞ":  "ञ",
+		"ট":  "ट",
+		"ঠ":  "ठ",
+		"ড":  "ड",
+		"ঢ":  "ढ",
+		"ণ":  "ण",
+		"ত":  "त",
+		"থ":  "थ",
+		"দ":  "द",
+		"ধ":  "ध",
+		"ন":  "न",
+		"প":  "प",
+		"ফ":  "फ",
+		"ব":  "ब",
+		"ভ":  "भ",
+		"ম":  "म",
+		"য":  "य",
+		"র":  "र",
+		"ল":  "ल",
+		"শ":  "श",
+		"ষ":  "ष",
+		"স":  "स",
+		"হ":  "ह",
+		"ল়": "ळ",
+		"ৎ":  "त्",
+	},
+	as: {
+		"।":  "।",
+		"॥":  "॥",
+		"ঽ":  "ऽ",
+		"ওঁ": "ॐ",
+		"০":  "०",
+		"১":  "१",
+		"২":  "२",
+		"৩":  "३",
+		"৪":  "४",
+		"৫":  "५",
+		"৬":  "६",
+		"৭":  "७",
+		"৮":  "८",
+		"৯":  "९",
+		"অ":  "अ",
+		"আ":  "आ",
+		"ই":  "इ",
+		"ঈ":  "ई",
+		"উ":  "उ",
+		"ঊ":  "ऊ",
+		"ঋ":  "ऋ",
+		"ৠ":  "ॠ",
+		"ঌ":  "ऌ",
+		"ৡ":  "ॡ",
+		"এ":  "ए",
+		"ঐ":  "ऐ",
+		"ও":  "ओ",
+		"ঔ":  "औ",
+		"া":  "ा",
+		"ি":  "ि",
+		"ী":  "ी",
+		"ু":  "ु",
+		"ূ":  "ू",
+		"ৃ":  "ृ",
+		"ৄ":  "ॄ",
+		"ৢ":  "ॢ",
+		"ৣ":  "ॣ",
+		"ে":  "े",
+		"ৈ":  "ै",
+		"ো":  "ो",
+		"ৌ":  "ौ",
+		"ং":  "ं",
+		"ঃ":  "ः",
+		"ঁ":  "ँ",
+		"্":  "्",
+		"ক":  "क",
+		"খ":  "ख",
+		"গ":  "ग",
+		"ঘ":  "घ",
+		"ঙ":  "ङ",
+		"চ":  "च",
+		"ছ":  "छ",
+		"জ":  "ज",
+		"ঝ":  "झ",
+		"ঞ":  "ञ",
+		"ট":  "ट",
+		"ঠ":  "ठ",
+		"ড":  "ड",
+		"ঢ":  "ढ",
+		"ণ":  "ण",
+		"ত":  "त",
+		"থ":  "थ",
+		"দ":  "द",
+		"ধ":  "ध",
+		"ন":  "न",
+		"প":  "प",
+		"ফ":  "फ",
+		"ব":  "ब",
+		"ভ":  "भ",
+		"ম":  "म",
+		"য":  "य",
+		"ৰ":  "र",
+		"ল":  "ल",
+		"ৱ":  "व",
+		"শ":  "श",
+		"ষ":  "ष",
+		"স":  "स",
+		"হ":  "ह",
+		"ল়": "ळ",
+		"ৎ":  "त्",
+	},
+	pa: {
+		"।":  "।",
+		"॥":  "॥",
+		"ऽ":  "ऽ",
+		"ਓਂ": "ॐ",
+		"੦":  "०",
+		"੧":  "१",
+		"੨":  "२",
+		"੩":  "३",
+		"੪":  "४",
+		"੫":  "५",
+		"੬":  "६",
+		"੭":  "७",
+		"੮":  "८",
+		"੯":  "९",
+		"ਅ":  "अ",
+		"ਆ":  "आ",
+		"ਇ":  "इ",
+		"ਈ":  "ई",
+		"ਉ":  "उ",
+		"ਊ":  "ऊ",
+		"ਏ":  "ए",
+		"ਐ":  "ऐ",
+		"ਓ":  "ओ",
+		"ਔ":  "औ",
+		"ਾ":  "ा",
+		"ਿ":  "ि",
+		"ੀ":  "ी",
+		"ੁ":  "ु",
+		"ੂ":  "ू",
+		"ੇ":  "े",
+		"ੈ":  "ै",
+		"ੋ":  "ो",
+		"ੌ":  "ौ",
+		"ਂ":  "ं",
+		"ਃ":  "ः",
+		"ਁ":  "ँ",
+		"੍":  "्",
+		"ਕ":  "क",
+		"ਖ":  "ख",
+		"ਗ":  "ग",
+		"ਘ":  "घ",
+		"ਙ":  "ङ",
+		"ਚ":  "च",
+		"ਛ":  "छ",
+		"ਜ":  "ज",
+		"ਝ":  "झ",
+		"ਞ":  "ञ",
+		"ਟ":  "ट",
+		"ਠ":  "ठ",
+		"ਡ":  "ड",
+		"ਢ":  "ढ",
+		"ਣ":  "ण",
+		"ਤ":  "त",
+		"ਥ":  "थ",
+		"ਦ":  "द",
+		"ਧ":  "ध",
+		"ਨ":  "न",
+		"ਪ":  "प",
+		"ਫ":  "फ",
+		"ਬ":  "ब",
+		"ਭ":  "भ",
+		"ਮ":  "म",
+		"ਯ":  "य",
+		"ਰ":  "र",
+		"ਲ":  "ल",
+		"ਵ":  "व",
+		"ਸ਼": "श",
+		"ਸ":  "स",
+		"ਹ":  "ह",
+		"ਲ਼": "ळ",
+		"ੰ":  "ं",
+	},
+
+	si: {
+		"।":  "।",
+		"॥":  "॥",
+		"ऽ":  "ऽ",
+		"ඕං": "ॐ",
+		"0":  "०",
+		"1":  "१",
+		"2":  "२",
+		"3":  "३",
+		"4":  "४",
+		"5":  "५",
+		"6":  "६",
+		"7":  "७",
+		"8":  "८",
+		"9":  "९",
+		"අ":  "अ",
+		"ආ":  "आ",
+		"ඉ":  "इ",
+		"ඊ":  "ई",
+		"උ":  "उ",
+		"ඌ":  "ऊ",
+		"ඍ":  "ऋ",
+		"ඎ":  "ॠ",
+		"ඏ":  "ऌ",
+		"ඐ":  "ॡ",
+		"ඒ":  "ए",
+		"එ":  "ऎ",
+		"ඓ":  "ऐ",
+		"ඕ":  "ओ",
+		"ඔ":  "ऒ",
+		"ඖ":  "औ",
+		"ා":  "ा",
+		"ි":  "ि",
+		"ී":  "ी",
+		"ු":  "ु",
+		"ූ":  "ू",
+		"ෘ":  "ृ",
+		"ෲ":  "ॄ",
+		"ෟ":  "ॢ",
+		"ෳ":  "ॣ",
+		"ේ":  "े",
+		"ෙ":  "ॆ",
+		"ෛ":  "ै",
+		"ෝ":  "ो",
+		"ො":  "ॊ",
+		"ෞ":  "ौ",
+		"ං":  "ं",
+		"ඃ":  "ः",
+		"ඁ":  "ँ",
+		"්":  "्",
+		"්‍": "्",
+		"ක":  "क",
+		"ඛ":  "ख",
+		"ග":  "ग",
+		"ඝ":  "घ",
+		"ඞ":  "ङ",
+		"ච":  "च",
+		"ඡ":  "छ",
+		"ජ":  "ज",
+		"ඣ":  "झ",
+		"ඤ":  "ञ",
+		"ට":  "ट",
+		"ඨ":  "ठ",
+		"ඩ":  "ड",
+		"ඪ":  "ढ",
+		"ණ":  "ण",
+		"ත":  "त",
+		"ථ":  "थ",
+		"ද":  "द",
+		"ධ":  "ध",
+		"න":  "न",
+		"ප":  "प",
+		"ඵ":  "फ",
+		"බ":  "ब",
+		"භ":  "भ",
+		"ම":  "म",
+		"ය":  "य",
+		"ර":  "र",
+		"ල":  "ल",
+		"ව":  "व",
+		"ශ":  "श",
+		"ෂ":  "ष",
+		"ස":  "स",
+		"හ":  "ह",
+		"ළ":  "ळ",
+	},
+	brah: {
+		"𑁇":  "।",
+		"𑁈":  "॥",
+		"ऽ":  "ऽ",
+		"𑀑𑀁": "ॐ",
+		"𑁦":  "०",
+		"𑁧":  "१",
+		"𑁨":  "२",
+		"𑁩":  "३",
+		"𑁪":  "४",
+		"𑁫":  "५",
+		"𑁬":  "६",
+		"𑁭":  "७",
+		"𑁮":  "८",
+		"𑁯":  "९",
+		"𑀅":  "अ",
+		"𑀆":  "आ",
+		"𑀇":  "इ",
+		"𑀈":  "ई",
+		"𑀉":  "उ",
+		"𑀊":  "ऊ",
+		"𑀋":  "ऋ",
+		"𑀌":  "ॠ",
+		"𑀍":  "ऌ",
+		"𑀎":  "ॡ",
+		"𑀏":  "ए",
+		"𑀐":  "ऐ",
+		"𑀑":  "ओ",
+		"𑀒":  "औ",
+		"𑀸":  "ा",
+		"𑀺":  "ि",
+		"𑀻":  "ी",
+		"𑀼":  "ु",
+		"𑀽":  "ू",
+		"𑀾":  "ृ",
+		"𑀿":  "ॄ",
+		"𑁀":  "ॢ",
+		"𑁁":  "ॣ",
+		"𑁂":  "े",
+		"𑁃":  "ै",
+		"𑁄":  "ो",
+		"𑁅":  "ौ",
+		"𑀁":  "ं",
+		"𑀂":  "ः",
+		"𑀀":  "ँ",
+		"𑁆":  "्",
+		"𑀓":  "क",
+		"𑀔":  "ख",
+		"𑀕":  "ग",
+		"𑀖":  "घ",
+		"𑀗":  "ङ",
+		"𑀘":  "च",
+		"𑀙":  "छ",
+		"𑀚":  "ज",
+		"𑀛":  "झ",
+		"𑀜":  "ञ",
+		"𑀝":  "ट",
+		"𑀞":  "ठ",
+		"𑀟":  "ड",
+		"𑀠":  "ढ",
+		"𑀡":  "ण",
+		"𑀢":  "त",
+		"𑀣":  "थ",
+		"𑀤":  "द",
+		"𑀥":  "ध",
+		"𑀦":  "न",
+		"𑀧":  "प",
+		"𑀨":  "फ",
+		"𑀩":  "ब",
+		"𑀪":  "भ",
+		"𑀫":  "म",
+		"𑀬":  "य",
+		"𑀭":  "र",
+		"𑀮":  "ल",
+		"𑀯":  "व",
+		"𑀰":  "श",
+		"𑀱":  "ष",
+		"𑀲":  "स",
+		"𑀳":  "ह",
+		"𑀴":  "ळ",
+	},
+	shrd: {
+		"𑇅": "।",
+		"𑇆": "॥",
+		"𑇁": "ऽ",
+		"𑇄": "ॐ",
+		"𑇐": "०",
+		"𑇑": "१",
+		"𑇒": "२",
+		"𑇓": "३",
+		"𑇔": "४",
+		"𑇕": "५",
+		"𑇖": "६",
+		"𑇗": "७",
+		"𑇘": "८",
+		"𑇙": "९",
+		"𑆃": "अ",
+		"𑆄": "आ",
+		"𑆅": "इ",
+		"𑆆": "ई",
+		"𑆇": "उ",
+		"𑆈": "ऊ",
+		"𑆉": "ऋ",
+		"𑆊": "ॠ",
+		"𑆋": "ऌ",
+		"𑆌": "ॡ",
+		"𑆍": "ए",
+		"𑆎": "ऐ",
+		"𑆏": "ओ",
+		"𑆐": "औ",
+		"𑆳": "ा",
+		"𑆴": "ि",
+		"𑆵": "ी",
+		"𑆶": "ु",
+		"𑆷": "ू",
+		"𑆸": "ृ",
+		"𑆹": "ॄ",
+		"𑆺": "ॢ",
+		"𑆻": "ॣ",
+		"𑆼": "े",
+		"𑆽": "ै",
+		"𑆾": "ो",
+		"𑆿": "ौ",
+		"𑆁": "ं",
+		"𑆂": "ः",
+		"𑆀": "ँ",
+		"𑇀": "्",
+		"𑆑": "क",
+		"𑆒": "ख",
+		"𑆓": "ग",
+		"𑆔": "घ",
+		"𑆕": "ङ",
+		"𑆖": "च",
+		"𑆗": "छ",
+		"𑆘": "ज",
+		"𑆙": "झ",
+		"𑆚": "ञ",
+		"𑆛": "ट",
+		"𑆜": "ठ",
+		"𑆝": "ड",
+		"𑆞": "ढ",
+		"𑆟": "ण",
+		"𑆠": "त",
+		"𑆡": "थ",
+		"𑆢": "द",
+		"𑆣": "ध",
+		"𑆤": "न",
+		"𑆥": "प",
+		"𑆦": "फ",
+		"𑆧": "ब",
+		"𑆨": "भ",
+		"𑆩": "म",
+		"𑆪": "य",
+		"𑆫": "र",
+		"𑆬": "ल",
+		"𑆮": "व",
+		"𑆯": "श",
+		"𑆰": "ष",
+		"𑆱": "स",
+		"𑆲": "ह",
+		"𑆭": "ळ",
+	},
+	sidd: {
+		"𑗂":  "।",
+		"𑗃":  "॥",
+		"ऽ":  "ऽ",
+		"𑖌𑖽": "ॐ",
+		"०":  "०",
+		"१":  "१",
+		"२":  "२",
+		"३":  "३",
+		"४":  "४",
+		"५":  "५",
+		"६":  "६",
+		"७":  "७",
+		"८":  "८",
+		"९":  "९",
+		"𑖀":  "अ",
+		"𑖁":  "आ",
+		"𑖂":  "इ",
+		"𑖃":  "ई",
+		"𑖄":  "उ",
+		"𑖅":  "ऊ",
+		"𑖆":  "ऋ",
+		"𑖇":  "ॠ",
+		"𑖈":  "ऌ",
+		"𑖉":  "ॡ",
+		"𑖊":  "ए",
+		"𑖋":  "ऐ",
+		"𑖌":  "ओ",
+		"𑖍":  "औ",
+		"𑖯":  "ा",
+		"𑖰":  "ि",
+		"𑖱":  "ी",
+		"𑖲":  "ु",
+		"𑖳":  "ू",
+		"𑖴":  "ृ",
+		"𑖵":  "ॄ",
+		"𑖿𑖈": "ॢ",
+		"𑖿𑖉": "ॣ",
+		"𑖸":  "े",
+		"𑖹":  "ै",
+		"𑖺":  "ो",
+		"𑖻":  "ौ",
+		"𑖽":  "ं",
+		"𑖾":  "ः",
+		"𑖼":  "ँ",
+		"𑖿":  "्",
+		"𑖎":  "क",
+		"𑖏":  "ख",
+		"𑖐":  "ग",
+		"𑖑":  "घ",
+		"𑖒":  "ङ",
+		"𑖓":  "च",
+		"𑖔":  "छ",
+		"𑖕":  "ज",
+		"𑖖":  "झ",
+		"𑖗":  "ञ",
+		"𑖘":  "ट",
+		"𑖙":  "ठ",
+		"𑖚":  "ड",
+		"𑖛":  "ढ",
+		"𑖜":  "ण",
+		"𑖝":  "त",
+		"𑖞":  "थ",
+		"𑖟":  "द",
+		"𑖠":  "ध",
+		"𑖡":  "न",
+		"𑖢":  "प",
+		"𑖣":  "फ",
+		"𑖤":  "ब",
+		"𑖥":  "भ",
+		"𑖦":  "म",
+		"𑖧":  "य",
+		"𑖨":  "र",
+		"𑖩":  "ल",
+		"𑖪":  "व",
+		"𑖫":  "श",
+		"𑖬":  "ष",
+		"𑖭":  "स",
+		"𑖮":  "ह",
+	},
+	newa: {
+		"𑑋": "।",
+		"𑑌": "॥",
+		"𑑇": "ऽ",
+		"𑑉": "ॐ",
+		"𑑐": "०",
+		"𑑑": "१",
+		"𑑒": "२",
+		"𑑓": "३",
+		"𑑔": "४",
+		"𑑕": "५",
+		"𑑖": "६",
+		"𑑗": "७",
+		"𑑘": "८",
+		"𑑙": "९",
+		"𑐀": "अ",
+		"𑐁": "आ",
+		"𑐂": "इ",
+		"𑐃": "ई",
+		"𑐄": "उ",
+		"𑐅": "ऊ",
+		"𑐆": "ऋ",
+		"𑐇": "ॠ",
+		"𑐈": "ऌ",
+		"𑐉": "ॡ",
+		"𑐊": "ए",
+		"𑐋": "ऐ",
+		"𑐌": "ओ",
+		"𑐍": "औ",
+		"𑐵": "ा",
+		"𑐶": "ि",
+		"𑐷": "ी",
+		"𑐸": "ु",
+		"𑐹": "ू",
+		"𑐺": "ृ",
+		"𑐻": "ॄ",
+		"𑐼": "ॢ",
+		"𑐽": "ॣ",
+		"𑐾": "े",
+		"𑐿": "ै",
+		"𑑀": "ो",
+		"𑑁": "ौ",
+		"𑑄": "ं",
+		"𑑅": "ः",
+		"𑑃": "ँ",
+		"𑑂": "्",
+		"𑐎": "क",
+		"𑐏": "ख",
+		"𑐐": "ग",
+		"𑐑": "घ",
+		"𑐒": "ङ",
+		"𑐔": "च",
+		"𑐕": "छ",
+		"𑐖": "ज",
+		"𑐗": "झ",
+		"𑐘": "ञ",
+		"𑐚": "ट",
+		"𑐛": "ठ",
+		"𑐜": "ड",
+		"𑐝": "ढ",
+		"𑐞": "ण",
+		"𑐟": "त",
+		"𑐠": "थ",
+		"𑐡": "द",
+		"𑐢": "ध",
+		"𑐣": "न",
+		"𑐥": "प",
+		"𑐦": "फ",
+		"𑐧": "ब",
+		"𑐨": "भ",
+		"𑐩": "म",
+		"𑐫": "य",
+		"𑐬": "र",
+		"𑐮": "ल",
+		"𑐰": "व",
+		"𑐱": "श",
+		"𑐲": "ष",
+		"𑐳": "स",
+		"𑐴": "ह",
+	},
+	gran: {
 		"।": "।",
 		"॥": "॥",
 		"𑌽": "ऽ",
@@ -1530,10 +4295,13 @@ var devanāgarīScriptDict = map[langList]charMap{
 		"𑍇": "े",
 		"𑍈": "ै",
 		"𑍋": "ो",
-		"𑍗": "ौ",
+		"𑍌": "ौ",
 		"𑌂": "ं",
 		"𑌃": "ः",
 		"𑌁": "ँ",
+		"ᳵ": "ᳵ",
+		"ᳶ": "ᳶ",
+		"ꣳ": "ꣳ",
 		"𑍍": "्",
 		"𑌕": "क",
 		"𑌖": "ख",
@@ -1570,6 +4338,570 @@ var devanāgarīScriptDict = map[langList]charMap{
 		"𑌹": "ह",
 		"𑌳": "ळ",
 	},
+	taml: {
+		"।":    "।",
+		"॥":    "॥",
+		"ऽ":    "ऽ",
+		"ௐ":    "ॐ",
+		"௦":    "०",
+		"௧":    "१",
+		"௨":    "२",
+		"௩":    "३",
+		"௪":    "४",
+		"௫":    "५",
+		"௬":    "६",
+		"௭":    "७",
+		"௮":    "८",
+		"௯":    "९",
+		"அ":    "अ",
+		"ஆ":    "आ",
+		"இ":    "इ",
+		"ஈ":    "ई",
+		"உ":    "उ",
+		"ஊ":    "ऊ",
+		"ருʼ":  "ऋ",
+		"ரூʼ":  "ॠ",
+		"லுʼ":  "ऌ",
+		"லூʼ":  "ॡ",
+		"ஏ":    "ए",
+		"எ":    "ऎ",
+		"ஐ":    "ऐ",
+		"ஓ":    "ओ",
+		"ஒ":    "ऒ",
+		"ஔ":    "औ",
+		"ா":    "ा",
+		"ி":    "ि",
+		"ீ":    "ी",
+		"ு":    "ु",
+		"ூ":    "ू",
+		"்ருʼ": "ृ",
+		"்ரூʼ": "ॄ",
+		"்லுʼ": "ॢ",
+		"்லூʼ": "ॣ",
+		"ே":    "े",
+		"ெ":    "ॆ",
+		"ை":    "ै",
+		"ோ":    "ो",
+		"ொ":    "ॊ",
+		"ௌ":    "ौ",
+		"ஃ":    "ः",
+		"்":    "्",
+		"க":    "क",
+		"க²":   "ख",
+		"க³":   "ग",
+		"க⁴":   "घ",
+		"ங":    "ङ",
+		"ச":    "च",
+		"ச²":   "छ",
+		"ஜ":    "ज",
+		"ஜ⁴":   "झ",
+		"ஞ":    "ञ",
+		"ட":    "ट",
+		"ட²":   "ठ",
+		"ட³":   "ड",
+		"ட⁴":   "ढ",
+		"ண":    "ण",
+		"த":    "त",
+		"த²":   "थ",
+		"த³":   "द",
+		"த⁴":   "ध",
+		"ந":    "न",
+		"ப":    "प",
+		"ப²":   "फ",
+		"ப³":   "ब",
+		"ப⁴":   "भ",
+		"ம":    "म",
+		"ய":    "य",
+		"ர":    "र",
+		"ல":    "ल",
+		"வ":    "व",
+		"ஶ":    "श",
+		"ஷ":    "ष",
+		"ஸ":    "स",
+		"ஹ":    "ह",
+		"ள":    "ळ",
+	},
+
+	thai: {
+		"ฯ":   "।",
+		"๚":   "॥",
+		"ऽ":   "ऽ",
+		"อโํ": "ॐ",
+		"๐":   "०",
+		"๑":   "१",
+		"๒":   "२",
+		"๓":   "३",
+		"๔":   "४",
+		"๕":   "५",
+		"๖":   "६",
+		"๗":   "७",
+		"๘":   "८",
+		"๙":   "९",
+		"อ":   "अ",
+		"อา":  "आ",
+		"อิ":  "इ",
+		"อี":  "ई",
+		"อุ":  "उ",
+		"อู":  "ऊ",
+		"ฤ":   "ऋ",
+		"ฤๅ":  "ॠ",
+		"ฦ":   "ऌ",
+		"ฦๅ":  "ॡ",
+		"อเ":  "ए",
+		"อไ":  "ऐ",
+		"อโ":  "ओ",
+		"อเา": "औ",
+		"า":   "ा",
+		"ิ":   "ि",
+		"ี":   "ी",
+		"ุ":   "ु",
+		"ู":   "ू",
+		"ฺฤ":  "ृ",
+		"ฺฤๅ": "ॄ",
+		"ฺฦ":  "ॢ",
+		"ฺฦๅ": "ॣ",
+		"เ":   "े",
+		"ไ":   "ै",
+		"โ":   "ो",
+		"เา":  "ौ",
+		"ํ":   "ं",
+		"ะ":   "ः",
+		"ฺ":   "्",
+		"ก":   "क",
+		"ข":   "ख",
+		"ค":   "ग",
+		"ฆ":   "घ",
+		"ง":   "ङ",
+		"จ":   "च",
+		"ฉ":   "छ",
+		"ช":   "ज",
+		"ฌ":   "झ",
+		"ญ":   "ञ",
+		"ฏ":   "ट",
+		"ฐ":   "ठ",
+		"ฑ":   "ड",
+		"ฒ":   "ढ",
+		"ณ":   "ण",
+		"ต":   "त",
+		"ถ":   "थ",
+		"ท":   "द",
+		"ธ":   "ध",
+		"น":   "न",
+		"ป":   "प",
+		"ผ":   "फ",
+		"พ":   "ब",
+		"ภ":   "भ",
+		"ม":   "म",
+		"ย":   "य",
+		"ร":   "र",
+		"ล":   "ल",
+		"ว":   "व",
+		"ศ":   "श",
+		"ษ":   "ष",
+		"ส":   "स",
+		"ห":   "ह",
+		"ฬ":   "ळ",
+	},
+
+	khmr: {
+		"។":  "।",
+		"៕":  "॥",
+		"ऽ":  "ऽ",
+		"ឱំ": "ॐ",
+		"០":  "०",
+		"១":  "१",
+		"២":  "२",
+		"៣":  "३",
+		"៤":  "४",
+		"៥":  "५",
+		"៦":  "६",
+		"៧":  "७",
+		"៨":  "८",
+		"៩":  "९",
+		"អ":  "अ",
+		"អា": "आ",
+		"ឥ":  "इ",
+		"ឦ":  "ई",
+		"ឧ":  "उ",
+		"ឩ":  "ऊ",
+		"ឫ":  "ऋ",
+		"ឬ":  "ॠ",
+		"ឭ":  "ऌ",
+		"ឮ":  "ॡ",
+		"ឯ":  "ए",
+		"ឰ":  "ऐ",
+		"ឱ":  "ओ",
+		"ឳ":  "औ",
+		"ា":  "ा",
+		"ិ":  "ि",
+		"ី":  "ी",
+		"ុ":  "ु",
+		"ូ":  "ू",
+		"្ឫ": "ृ",
+		"្ឬ": "ॄ",
+		"្ឭ": "ॢ",
+		"្ឮ": "ॣ",
+		"េ":  "े",
+		"ៃ":  "ै",
+		"ោ":  "ो",
+		"ៅ":  "ौ",
+		"ំ":  "ं",
+		"ះ":  "ः",
+		"្":  "्",
+		"ក":  "क",
+		"ខ":  "ख",
+		"គ":  "ग",
+		"ឃ":  "घ",
+		"ង":  "ङ",
+		"ច":  "च",
+		"ឆ":  "छ",
+		"ជ":  "ज",
+		"ឈ":  "झ",
+		"ញ":  "ञ",
+		"ដ":  "ट",
+		"ឋ":  "ठ",
+		"ឌ":  "ड",
+		"ឍ":  "ढ",
+		"ណ":  "ण",
+		"ត":  "त",
+		"ថ":  "थ",
+		"ទ":  "द",
+		"ធ":  "ध",
+		"ន":  "न",
+		"ប":  "प",
+		"ផ":  "फ",
+		"ព":  "ब",
+		"ភ":  "भ",
+		"ម":  "म",
+		"យ":  "य",
+		"រ":  "र",
+		"ល":  "ल",
+		"វ":  "व",
+		"ឝ":  "श",
+		"ឞ":  "ष",
+		"ស":  "स",
+		"ហ":  "ह",
+		"ឡ":  "ळ",
+		"៑":  "्",
+	},
+
+	bali: {
+		"᭞":  "।",
+		"᭟":  "॥",
+		"ऽ":  "ऽ",
+		"ᬑᬂ": "ॐ",
+		"᭐":  "०",
+		"᭑":  "१",
+		"᭒":  "२",
+		"᭓":  "३",
+		"᭔":  "४",
+		"᭕":  "५",
+		"᭖":  "६",
+		"᭗":  "७",
+		"᭘":  "८",
+		"᭙":  "९",
+		"ᬅ":  "अ",
+		"ᬆ":  "आ",
+		"ᬇ":  "इ",
+		"ᬈ":  "ई",
+		"ᬉ":  "उ",
+		"ᬊ":  "ऊ",
+		"ᬋ":  "ऋ",
+		"ᬌ":  "ॠ",
+		"ᬍ":  "ऌ",
+		"ᬎ":  "ॡ",
+		"ᬏ":  "ए",
+		"ᬐ":  "ऐ",
+		"ᬑ":  "ओ",
+		"ᬒ":  "औ",
+		"ᬵ":  "ा",
+		"ᬶ":  "ि",
+		"ᬷ":  "ी",
+		"ᬸ":  "ु",
+		"ᬹ":  "ू",
+		"ᬺ":  "ृ",
+		"ᬻ":  "ॄ",
+		"ᬼ":  "ॢ",
+		"ᬽ":  "ॣ",
+		"ᬾ":  "े",
+		"ᬿ":  "ै",
+		"ᭀ":  "ो",
+		"ᭁ":  "ौ",
+		"ᬂ":  "ं",
+		"ᬄ":  "ः",
+		"ᬁ":  "ँ",
+		"᭄":  "्",
+		"ᬓ":  "क",
+		"ᬔ":  "ख",
+		"ᬕ":  "ग",
+		"ᬖ":  "घ",
+		"ᬗ":  "ङ",
+		"ᬘ":  "च",
+		"ᬙ":  "छ",
+		"ᬚ":  "ज",
+		"ᬛ":  "झ",
+		"ᬜ":  "ञ",
+		"ᬝ":  "ट",
+		"ᬞ":  "ठ",
+		"ᬟ":  "ड",
+		"ᬠ":  "ढ",
+		"ᬡ":  "ण",
+		"ᬢ":  "त",
+		"ᬣ":  "थ",
+		"ᬤ":  "द",
+		"ᬥ":  "ध",
+		"ᬦ":  "न",
+		"ᬧ":  "प",
+		"ᬨ":  "फ",
+		"ᬩ":  "ब",
+		"ᬪ":  "भ",
+		"ᬫ":  "म",
+		"ᬬ":  "य",
+		"ᬭ":  "र",
+		"ᬮ":  "ल",
+		"ᬯ":  "व",
+		"ᬰ":  "श",
+		"ᬱ":  "ष",
+		"ᬲ":  "स",
+		"ᬳ":  "ह",
+	},
+
+	java: {
+		"꧈":  "।",
+		"꧉":  "॥",
+		"ऽ":  "ऽ",
+		"ꦎꦁ": "ॐ",
+		"꧐":  "०",
+		"꧑":  "१",
+		"꧒":  "२",
+		"꧓":  "३",
+		"꧔":  "४",
+		"꧕":  "५",
+		"꧖":  "६",
+		"꧗":  "७",
+		"꧘":  "८",
+		"꧙":  "९",
+		"ꦄ":  "अ",
+		"ꦄꦴ": "आ",
+		"ꦆ":  "इ",
+		"ꦇ":  "ई",
+		"ꦈ":  "उ",
+		"ꦈꦴ": "ऊ",
+		"ꦉ":  "ऋ",
+		"ꦉꦴ": "ॠ",
+		"ꦊ":  "ऌ",
+		"ꦋ":  "ॡ",
+		"ꦌ":  "ए",
+		"ꦍ":  "ऐ",
+		"ꦎ":  "ओ",
+		"ꦎꦴ": "औ",
+		"ꦴ":  "ा",
+		"ꦶ":  "ि",
+		"ꦷ":  "ी",
+		"ꦸ":  "ु",
+		"ꦹ":  "ू",
+		"ꦽ":  "ृ",
+		"ꦽꦴ": "ॄ",
+		"꧀ꦊ": "ॢ",
+		"꧀ꦋ": "ॣ",
+		"ꦺ":  "े",
+		"ꦻ":  "ै",
+		"ꦺꦴ": "ो",
+		"ꦻꦴ": "ौ",
+		"ꦁ":  "ं",
+		"ꦃ":  "ः",
+		"ꦀ":  "ँ",
+		"꧀":  "्",
+		"ꦏ":  "क",
+		"ꦑ":  "ख",
+		"ꦒ":  "ग",
+		"ꦓ":  "घ",
+		"ꦔ":  "ङ",
+		"ꦕ":  "च",
+		"ꦖ":  "छ",
+		"ꦗ":  "ज",
+		"ꦙ":  "झ",
+		"ꦚ":  "ञ",
+		"ꦛ":  "ट",
+		"ꦜ":  "ठ",
+		"ꦝ":  "ड",
+		"ꦞ":  "ढ",
+		"ꦟ":  "ण",
+		"ꦠ":  "त",
+		"ꦡ":  "थ",
+		"ꦢ":  "द",
+		"ꦣ":  "ध",
+		"ꦤ":  "न",
+		"ꦥ":  "प",
+		"ꦦ":  "फ",
+		"ꦧ":  "ब",
+		"ꦨ":  "भ",
+		"ꦩ":  "म",
+		"ꦪ":  "य",
+		"ꦫ":  "र",
+		"ꦭ":  "ल",
+		"ꦮ":  "व",
+		"ꦯ":  "श",
+		"ꦰ":  "ष",
+		"ꦱ":  "स",
+		"ꦲ":  "ह",
+	},
+
+	mymr: {
+		"၊":   "।",
+		"။":   "॥",
+		"ऽ":   "ऽ",
+		"ဥုံ": "ॐ",
+		"၀":   "०",
+		"၁":   "१",
+		"၂":   "२",
+		"၃":   "३",
+		"၄":   "४",
+		"၅":   "५",
+		"၆":   "६",
+		"၇":   "७",
+		"၈":   "८",
+		"၉":   "९",
+		"အ":   "अ",
+		"အာ":  "आ",
+		"ဣ":   "इ",
+		"ဤ":   "ई",
+		"ဥ":   "उ",
+		"ဦ":   "ऊ",
+		"ၒ":   "ऋ",
+		"ၓ":   "ॠ",
+		"ၔ":   "ऌ",
+		"ၕ":   "ॡ",
+		"ဧ":   "ए",
+		"အဲ":  "ऐ",
+		"ဩ":   "ओ",
+		"ဪ":   "औ",
+		"ာ":   "ा",
+		"ိ":   "ि",
+		"ီ":   "ी",
+		"ု":   "ु",
+		"ူ":   "ू",
+		"ၖ":   "ृ",
+		"ၗ":   "ॄ",
+		"ၘ":   "ॢ",
+		"ၙ":   "ॣ",
+		"ေ":   "े",
+		"ဲ":   "ै",
+		"ော":  "ो",
+		"ော်": "ौ",
+		"ံ":   "ं",
+		"း":   "ः",
+		"္":   "्",
+		"က":   "क",
+		"ခ":   "ख",
+		"ဂ":   "ग",
+		"ဃ":   "घ",
+		"င":   "ङ",
+		"စ":   "च",
+		"ဆ":   "छ",
+		"ဇ":   "ज",
+		"ဈ":   "झ",
+		"ဉ":   "ञ",
+		"ဋ":   "ट",
+		"ဌ":   "ठ",
+		"ဍ":   "ड",
+		"ဎ":   "ढ",
+		"ဏ":   "ण",
+		"တ":   "त",
+		"ထ":   "थ",
+		"ဒ":   "द",
+		"ဓ":   "ध",
+		"န":   "न",
+		"ပ":   "प",
+		"ဖ":   "फ",
+		"ဗ":   "ब",
+		"ဘ":   "भ",
+		"မ":   "म",
+		"ယ":   "य",
+		"ရ":   "र",
+		"လ":   "ल",
+		"ဝ":   "व",
+		"ၐ":   "श",
+		"ၑ":   "ष",
+		"သ":   "स",
+		"ဟ":   "ह",
+		"ဠ":   "ळ",
+		"်":   "्",
+		"ျ":   "्य",
+		"ြ":   "्र",
+		"ွ":   "्व",
+		"ှ":   "्ह",
+	},
+
+	braille: {
+		"⠲":  "।",
+		"⠲⠲": "॥",
+		"⠕⠰": "ॐ",
+		"⠼⠚": "०",
+		"⠼⠁": "१",
+		"⠼⠃": "२",
+		"⠼⠉": "३",
+		"⠼⠙": "४",
+		"⠼⠑": "५",
+		"⠼⠋": "६",
+		"⠼⠛": "७",
+		"⠼⠓": "८",
+		"⠼⠊": "९",
+		"⠁":  "अ",
+		"⠜":  "आ",
+		"⠊":  "इ",
+		"⠔":  "ई",
+		"⠥":  "उ",
+		"⠳":  "ऊ",
+		"⠐⠗": "ऋ",
+		"⠠⠗": "ॠ",
+		"⠐⠇": "ऌ",
+		"⠠⠇": "ॡ",
+		"⠑":  "ए",
+		"⠢":  "ऎ",
+		"⠌":  "ऐ",
+		"⠕":  "ओ",
+		"⠭":  "ऒ",
+		"⠪":  "औ",
+		"⠰":  "ं",
+		"⠠":  "ः",
+		"⠄":  "ँ",
+		"⠈":  "्",
+		"⠅":  "क",
+		"⠨":  "ख",
+		"⠛":  "ग",
+		"⠣":  "घ",
+		"⠬":  "ङ",
+		"⠉":  "च",
+		"⠡":  "छ",
+		"⠚":  "ज",
+		"⠴":  "झ",
+		"⠒":  "ञ",
+		"⠾":  "ट",
+		"⠺":  "ठ",
+		"⠫":  "ड",
+		"⠿":  "ढ",
+		"⠼":  "ण",
+		"⠞":  "त",
+		"⠹":  "थ",
+		"⠙":  "द",
+		"⠮":  "ध",
+		"⠝":  "न",
+		"⠏":  "प",
+		"⠖":  "फ",
+		"⠃":  "ब",
+		"⠘":  "भ",
+		"⠍":  "म",
+		"⠽":  "य",
+		"⠗":  "र",
+		"⠇":  "ल",
+		"⠧":  "व",
+		"⠩":  "श",
+		"⠯":  "ष",
+		"⠎":  "स",
+		"⠓":  "ह",
+		"⠸":  "ळ",
+	},
 	sa: {},
 }
 
@@ -1577,18 +4909,163 @@ var devanāgarīScriptDict = map[langList]charMap{
 var reverseDevanāgarīScriptDict = (func() map[langList]charMap {
 	d := map[langList]charMap{}
 
-	for ll, v := range devanāgarīScriptDict {
-		m := charMap{}
-		for k, v2 := range v {
-			m[v2] = k
-		}
-
-		d[ll] = m
+	for ll := range devanāgarīScriptDict {
+		d[ll] = reverseScriptDict(ll)
 	}
 
 	return d
 })()
 
+// Letters that some scripts lack, and the letter written in their place
+var fallbackDict = charMap{
+	"ĕ": "e",
+	"ŏ": "o",
+	"ẖ": "ḥ",
+	"ḫ": "ḥ",
+	"ṁ": "ṃ",
+}
+
+// Romanisations other than IAST, with their tables and the letters of
+// fallbackDict that they spell as IAST does
+var romanisations = map[string]struct {
+	dict charMap
+	same []string
+}{
+	"slp":      {dict: slpDataDict},
+	"hk":       {dict: hkDataDict},
+	"itrans":   {dict: itransDataDict},
+	"velthuis": {dict: velthuisDataDict},
+	"wx":       {dict: wxDataDict},
+	"iso":      {dict: isoDataDict, same: []string{"ẖ", "ḫ"}},
+	"cyrl":     {dict: cyrlDataDict},
+}
+
+// IsRoman reports whether a scheme is IAST or another romanisation
+func IsRoman(scheme string) bool {
+	_, ok := romanisations[scheme]
+	return ok || scheme == "iast"
+}
+
+// Find the letters that a romanisation has no spelling for, with the IAST
+// letter it writes in their place
+func romanFallbacks(lang string) charMap {
+	obj := romanisations[lang]
+	letters := reverseCharMap(obj.dict)
+
+	m := charMap{}
+	for k, v := range fallbackDict {
+		if _, ok := letters[k]; !ok && !slices.Contains(obj.same, k) {
+			m[k] = v
+		}
+	}
+
+	return m
+}
+
+// Create a conversion writing the letters that a romanisation has no
+// spelling for as the nearest letters it has, as in ṁ → ṃ
+func createRomanFallback(lang string) func(string) string {
+	var pairs []string
+	for k, v := range romanFallbacks(lang) {
+		pairs = append(pairs, k, v)
+	}
+
+	r := strings.NewReplacer(pairs...)
+	return func(s string) string {
+		return r.Replace(iastVedicIn.Replace(s))
+	}
+}
+
+// Consonants that every script writes as a conjunct of two others
+var conjunctDict = map[string][2]string{
+	"ḻh": {"ḻ", "h"},
+}
+
+// Reverse the script dict of a language. Where the script writes two
+// letters alike, as Bengali does with b and v, both devanāgarī letters map to
+// it. Letters the script lacks are written with their fallback.
+func reverseScriptDict(lang langList) charMap {
+	m := charMap{}
+
+	for _, v := range [][2]charMap{
+		{charDict[lang].numbers, charDict[sa].numbers},
+		{charDict[lang].vowels, charDict[sa].vowels},
+		{charDict[lang].vowelSigns, charDict[sa].vowelSigns},
+		{charDict[lang].consonants, charDict[sa].consonants},
+	} {
+		for _, k := range slices.Sorted(maps.Keys(v[0])) {
+			if c, d := v[0][k], v[1][k]; c != "" && d != "" {
+				if _, ok := m[d]; !ok {
+					m[d] = c
+				}
+			}
+		}
+	}
+
+	obj := devanāgarīScriptDict[lang]
+	for _, k := range slices.Sorted(maps.Keys(obj)) {
+		if _, ok := m[obj[k]]; !ok {
+			m[obj[k]] = k
+		}
+	}
+
+	for k, v := range fallbackDict {
+		for _, d := range []charMap{
+			charDict[sa].vowels,
+			charDict[sa].vowelSigns,
+		} {
+			if _, ok := m[d[k]]; ok {
+				continue
+			}
+
+			if c, ok := m[d[v]]; ok {
+				m[d[k]] = c
+			}
+		}
+	}
+
+	return m
+}
+
+// Copy a langMap, writing the fallback of letters the script lacks and the
+// conjuncts of conjunctDict
+func withFallback(obj langMap) langMap {
+	obj.vowels = maps.Clone(obj.vowels)
+	obj.vowelSigns = maps.Clone(obj.vowelSigns)
+	obj.consonants = maps.Clone(obj.consonants)
+
+	for k, v := range conjunctDict {
+		a, ok := obj.consonants[v[0]]
+		b, ok2 := obj.consonants[v[1]]
+		if _, ok3 := obj.consonants[k]; ok && ok2 && !ok3 {
+			obj.consonants[k] = a + obj.vowelSigns["-"] + b
+		}
+	}
+
+	for k, v := range fallbackDict {
+		for _, d := range []charMap{obj.vowels, obj.vowelSigns} {
+			if _, ok := d[k]; ok {
+				continue
+			}
+
+			if c, ok := d[v]; ok {
+				d[k] = c
+			}
+		}
+	}
+
+	return obj
+}
+
+func reverseCharMap(obj charMap) charMap {
+	m := charMap{}
+	for k, v := range obj {
+		m[v] = k
+	}
+
+	return m
+}
+
 func createDevanāgarīToScript(lang langList) func(string) string {
 	obj := reverseDevanāgarīScriptDict[lang]
 	return func(data string) string {
@@ -1598,6 +5075,8 @@ func createDevanāgarīToScript(lang langList) func(string) string {
 			c := string(v)
 			if k, ok := obj[c]; ok {
 				ans = append(ans, k)
+			} else if _, ok := devanāgarīAccentDict[c]; ok {
+				ans = append(ans, c)
 			}
 		}
 
@@ -1608,23 +5087,42 @@ func createDevanāgarīToScript(lang langList) func(string) string {
 func createScriptFunction(lang langList) func(string) string {
 	obj := devanāgarīScriptDict[lang]
 
+	var size int
+	for k := range obj {
+		size = max(size, utf8.RuneCountInString(k))
+	}
+
 	return func(s string) string {
+		var str []string
+		for _, v := range s {
+			str = append(str, string(v))
+		}
+
 		var arr []string
 
-		for _, v := range s {
-			l := string(v)
+		for i := 0; i < len(str); {
+			j := min(i+size, len(str))
+			for ; j > i; j-- {
+				if k, ok := obj[strings.Join(str[i:j], "")]; ok {
+					arr = append(arr, k)
+					break
+				}
+			}
 
-			if k, ok := obj[l]; ok {
-				arr = append(arr, k)
+			if j > i {
+				i = j
 				continue
 			}
 
 			if _, ok := slices.BinarySearch(
 				allowedSymbols,
-				l,
+				str[i],
 			); ok {
-				arr = append(arr, l)
+				arr = append(arr, str[i])
+			} else if _, ok := devanāgarīAccentDict[str[i]]; ok {
+				arr = append(arr, str[i])
 			}
+			i++
 		}
 
 		return strings.Join(arr, "")
@@ -1652,7 +5150,7 @@ func dataToIAST(data string) string {
 			continue
 		}
 
-		if split == "ḥ" || split == "ṃ" || split == "ã" {
+		if slices.Contains(ayogavāhas, split) {
 			ans = append(ans, split)
 			continue
 		}
@@ -1666,14 +5164,14 @@ func dataToIAST(data string) string {
 		for i := 0; i < len(str); {
 			curr := str[i]
 
-			if curr == "'" {
-				// arr = append(arr, "॑")
+			if v, ok := iastAccentDict[curr]; ok {
+				arr = append(arr, v)
 				i++
 				continue
 			}
 
-			if curr == "`" {
-				// arr = append(arr, "॒")
+			if _, ok := charDict[sa].numbers[curr]; ok {
+				arr = append(arr, curr)
 				i++
 				continue
 			}
@@ -1692,7 +5190,7 @@ func dataToIAST(data string) string {
 				next = str[i+1]
 			}
 
-			if next == "ḥ" || next == "ṃ" || next == "ã" {
+			if slices.Contains(ayogavāhas, next) {
 				if _, ok := charDict[sa].consonants[curr]; ok {
 					arr = append(arr, curr+"a"+next)
 				} else {
@@ -1710,7 +5208,7 @@ func dataToIAST(data string) string {
 			}
 
 			if i == len(str)-1 {
-				if curr == "ḥ" || curr == "ṃ" || curr == "ã" {
+				if slices.Contains(ayogavāhas, curr) {
 					arr = append(arr, curr)
 				} else {
 					arr = append(arr, curr+"a")
@@ -1732,7 +5230,7 @@ func dataToIAST(data string) string {
 					continue
 				}
 
-				if last == "ḥ" || last == "ṃ" || last == "ã" {
+				if slices.Contains(ayogavāhas, last) {
 					arr = append(arr, curr+next+"a"+last)
 					i += 3
 					continue
@@ -1760,7 +5258,7 @@ func dataToIAST(data string) string {
 				continue
 			}
 
-			if curr == "ḥ" || curr == "ṃ" || curr == "ã" {
+			if slices.Contains(ayogavāhas, curr) {
 				arr = append(arr, curr)
 				i++
 				continue
@@ -1778,7 +5276,19 @@ func dataToIAST(data string) string {
 		ans = append(ans, strings.Join(arr, ""))
 	}
 
-	return strings.Join(ans, "")
+	return iastVedicOut.Replace(diphthongAccentsOnA(strings.Join(ans, "")))
+}
+
+var (
+	diphthongAccentIAST = regexp.MustCompile("a([iu])([\u0300\u0301\u030b])")
+	diphthongAccentCyrl = regexp.MustCompile("а([иу])([\u0300\u0301\u030b])")
+)
+
+// Write the accent of ai and au on the a, as IAST does, in IAST and the
+// romanisations that spell them with two letters
+func diphthongAccentsOnA(s string) string {
+	s = diphthongAccentIAST.ReplaceAllString(s, "a$2$1")
+	return diphthongAccentCyrl.ReplaceAllString(s, "а$2$1")
 }
 
 // Convert IAST to UAST
@@ -1787,7 +5297,7 @@ func iastToUAST(data string) string {
 	for _, v := range string(
 		regexp.
 			MustCompile(`[\[\]{}^~@#$%&*\-_;<>]`).
-			ReplaceAll([]byte(data), []byte("")),
+			ReplaceAll([]byte(splitAccents(iastVedicIn.Replace(strings.ToLower(data)))), []byte("")),
 	) {
 		str = append(str, string(v))
 	}
@@ -1859,9 +5369,10 @@ func iastToUAST(data string) string {
 				continue
 			}
 
+			_, symbol := slices.BinarySearch(allowedSymbols, next)
 			if _, ok := charDict[sa].consonants[next]; ok ||
 				(next == "." || next == ".." || next == "'") ||
-				i == len(str)-1 {
+				symbol || i == len(str)-1 {
 				arr = append(arr, curr+"-")
 				i++
 				continue
@@ -1954,6 +5465,8 @@ func iastToUAST(data string) string {
 		l := string(v)
 		if k, ok := iastDataDict[l]; ok {
 			final = append(final, "/"+k+"/")
+		} else if k, ok := uastAccentDict[l]; ok {
+			final = append(final, k)
 		} else {
 			final = append(final, l)
 		}
@@ -1964,7 +5477,7 @@ func iastToUAST(data string) string {
 
 // Function to create the function of parser
 func createDataFunction(lang langList) func(string) string {
-	obj := charDict[lang]
+	obj := withFallback(charDict[lang])
 
 	return func(data string) string {
 		var ans []string
@@ -1994,18 +5507,16 @@ func createDataFunction(lang langList) func(string) string {
 			for i := 0; i < len(str); {
 				curr := str[i]
 
-				if lang == sa {
-					if curr == "'" {
-						arr = append(arr, "॑")
-						i++
-						continue
-					}
+				if v, ok := accentDict[curr]; ok {
+					arr = append(arr, v)
+					i++
+					continue
+				}
 
-					if curr == "`" {
-						arr = append(arr, "॒")
-						i++
-						continue
-					}
+				if v, ok := obj.numbers[curr]; ok {
+					arr = append(arr, v)
+					i++
+					continue
 				}
 
 				if _, ok := slices.BinarySearch(
@@ -2087,6 +5598,11 @@ func devanāgarīToUAST(data string) string {
 			continue
 		}
 
+		if curr == "᳚" {
+			arr = append(arr, "\\/''/")
+			continue
+		}
+
 		var val string
 		if v, ok := devanāgarīDataDict[curr]; ok {
 			val = v
@@ -2117,12 +5633,30 @@ func devanāgarīToUAST(data string) string {
 			}
 		}
 
+		// anusvāra, visarga and the like also end an independent vowel
+		for _, v := range ayogavāhas {
+			if charDict[sa].vowelSigns[v] == next {
+				checkConsonant = true
+				break
+			}
+		}
+
+		// and so do the Vedic marks, which UAST writes as they are
+		if slices.Contains(vedicMarks, next) {
+			checkConsonant = true
+		}
+
 		if checkVowel && checkConsonant {
 			arr = append(arr, val+"\\")
 			continue
 		}
 
-		if _, ok := slices.BinarySearch(unAspiratedConsonants, val); ok && nextVal == "h" {
+		letter := val
+		if v, ok := unicodeMap[strings.Trim(val, "/")]; ok && strings.HasPrefix(val, "/") {
+			letter = v
+		}
+
+		if _, ok := slices.BinarySearch(unAspiratedConsonants, letter); ok && nextVal == "h" {
 			arr = append(arr, val+"a")
 			continue
 		}
@@ -2139,12 +5673,131 @@ func slpToIAST(data string) string {
 	for _, v := range data {
 		if c, ok := slpDataDict[string(v)]; ok {
 			str = append(str, c)
+		} else {
+			str = append(str, string(v))
 		}
 	}
 
 	return strings.Join(str, "")
 }
 
+// Copy a dict of letters, adding each with its first letter in upper case,
+// for schemes that have case
+func withCapitals(dict charMap) charMap {
+	m := maps.Clone(dict)
+
+	for k, v := range dict {
+		if c := capitalise(k); c != k {
+			if _, ok := m[c]; !ok {
+				m[c] = capitalise(v)
+			}
+		}
+	}
+
+	return m
+}
+
+// Write the first letter of s in upper case
+func capitalise(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// Function to replace the longest matching keys of dict. Anything else is
+// dropped, unless keep is set.
+func createTokenFunction(dict charMap, keep bool) func(string) string {
+	var size int
+	for k := range dict {
+		size = max(size, utf8.RuneCountInString(k))
+	}
+
+	return func(data string) string {
+		var str []string
+		for _, v := range data {
+			str = append(str, string(v))
+		}
+
+		var ans []string
+
+		for i := 0; i < len(str); {
+			j := min(i+size, len(str))
+			for ; j > i; j-- {
+				if v, ok := dict[strings.Join(str[i:j], "")]; ok {
+					ans = append(ans, v)
+					break
+				}
+			}
+
+			if j > i {
+				i = j
+				continue
+			}
+
+			if keep {
+				ans = append(ans, str[i])
+			}
+			i++
+		}
+
+		return strings.Join(ans, "")
+	}
+}
+
+// Convert IAST to SLP1
+var iastToSLP = createTokenFunction(reverseCharMap(slpDataDict), true)
+
+// Convert Harvard-Kyoto to IAST
+var hkToIAST = createTokenFunction(hkDataDict, true)
+
+// Convert IAST to Harvard-Kyoto
+var iastToHK = createTokenFunction(reverseCharMap(hkDataDict), true)
+
+// Convert ITRANS, in any of its spellings, to IAST
+var itransToIAST = createTokenFunction(
+	func() charMap {
+		m := maps.Clone(itransDataDict)
+		maps.Copy(m, itransAliasDict)
+
+		return m
+	}(),
+	true,
+)
+
+// Convert IAST to canonical ITRANS
+var iastToITRANS = createTokenFunction(reverseCharMap(itransDataDict), true)
+
+// Convert Velthuis to IAST
+var velthuisToIAST = createTokenFunction(
+	func() charMap {
+		m := maps.Clone(velthuisDataDict)
+		maps.Copy(m, velthuisAliasDict)
+
+		return m
+	}(),
+	true,
+)
+
+// Convert IAST to Velthuis
+var iastToVelthuis = createTokenFunction(reverseCharMap(velthuisDataDict), true)
+
+// Convert WX to IAST
+var wxToIAST = createTokenFunction(wxDataDict, true)
+
+// Convert IAST to WX
+var iastToWX = createTokenFunction(reverseCharMap(wxDataDict), true)
+
+// Convert ISO 15919 to IAST, where short e and o are ĕ and ŏ
+var isoToIAST = createTokenFunction(withCapitals(isoDataDict), true)
+
+// Convert IAST to ISO 15919
+var iastToISO = createTokenFunction(reverseCharMap(withCapitals(isoDataDict)), true)
+
+// Convert Cyrillic to IAST
+var cyrlToIAST = createTokenFunction(withCapitals(cyrlDataDict), true)
+
+// Convert IAST to Cyrillic
+var iastToCyrl = createTokenFunction(reverseCharMap(withCapitals(cyrlDataDict)), true)
+
 type funcList string
 
 const (
@@ -2159,439 +5812,18 @@ type builder = map[langList](map[funcList](func(string) string))
 var builderFuncs = func() builder {
 	m := make(builder)
 
-	for _, v := range []langList{
-		gu,
-		sa,
-		ml,
-		or,
-		te,
-		kn,
-		ta,
-	} {
-		m[v] = map[funcList]func(string) string{
-			df: createDataFunction(v),
-			hu: createHandleUnicode(v),
-			sd: createScriptFunction(v),
-			ds: createDevanāgarīToScript(v),
-		}
+	for _, v := range append([]langList{sa}, scripts...) {
+		m[v] = createBuilder(v)
 	}
 
 	return m
 }()
 
-var Convertors = map[string](map[string]([]func(string) string)){
-	"uast-io": {
-		"iast": []func(string) string{
-			builderFuncs[sa][hu],
-		},
-		"devanāgarī": []func(string) string{
-			builderFuncs[sa][hu],
-			iastToUAST,
-			builderFuncs[sa][hu],
-			builderFuncs[sa][df],
-		},
-		"uast": []func(string) string{
-			builderFuncs[sa][hu],
-			iastToUAST,
-		},
-		"gu": []func(string) string{
-			builderFuncs[gu][hu],
-			iastToUAST,
-			builderFuncs[gu][hu],
-			builderFuncs[gu][df],
-		},
-		"or": []func(string) string{
-			builderFuncs[or][hu],
-			iastToUAST,
-			builderFuncs[or][hu],
-			builderFuncs[or][df],
-		},
-		"kn": []func(string) string{
-			builderFuncs[kn][hu],
-			iastToUAST,
-			builderFuncs[kn][hu],
-			builderFuncs[kn][df],
-		},
-		"ml": []func(string) string{
-			builderFuncs[ml][hu],
-			iastToUAST,
-			builderFuncs[ml][hu],
-			builderFuncs[ml][df],
-		},
-		"ta": []func(string) string{
-			builderFuncs[ta][hu],
-			iastToUAST,
-			builderFuncs[ta][hu],
-			builderFuncs[ta][df],
-		},
-		"te": []func(string) string{
-			builderFuncs[te][hu],
-			iastToUAST,
-			builderFuncs[te][hu],
-			builderFuncs[te][df],
-		},
-	},
-	"uast": {
-		"devanāgarī": []func(string) string{
-			builderFuncs[sa][hu],
-			builderFuncs[sa][df],
-		},
-		"iast": []func(string) string{
-			builderFuncs[sa][hu],
-			dataToIAST,
-		},
-		"gu": []func(string) string{
-			builderFuncs[gu][hu],
-			builderFuncs[gu][df],
-		},
-		"or": []func(string) string{
-			builderFuncs[or][hu],
-			builderFuncs[or][df],
-		},
-		"ta": []func(string) string{
-			builderFuncs[ta][hu],
-			builderFuncs[ta][df],
-		},
-		"te": []func(string) string{
-			builderFuncs[te][hu],
-			builderFuncs[te][df],
-		},
-		"kn": []func(string) string{
-			builderFuncs[kn][hu],
-			builderFuncs[kn][df],
-		},
-		"ml": []func(string) string{
-			builderFuncs[ml][hu],
-			builderFuncs[ml][df],
-		},
-	},
-	"devanāgarī": {
-		"uast": []func(string) string{
-			devanāgarīToUAST,
-		},
-		"iast": []func(string) string{
-			devanāgarīToUAST,
-			builderFuncs[sa][hu],
-			dataToIAST,
-		},
-		"gu": []func(string) string{
-			builderFuncs[gu][ds],
-		},
-		"or": []func(string) string{
-			builderFuncs[or][ds],
-		},
-		"kn": []func(string) string{
-			builderFuncs[kn][ds],
-		},
-		"te": []func(string) string{
-			builderFuncs[te][ds],
-		},
-		"ta": []func(string) string{
-			builderFuncs[ta][ds],
-		},
-		"ml": []func(string) string{
-			builderFuncs[ml][ds],
-		},
-	},
-	"slp": {
-		"iast": []func(string) string{
-			slpToIAST,
-		},
-		"uast": []func(string) string{
-			slpToIAST,
-			iastToUAST,
-		},
-		"devanāgarī": []func(string) string{
-			slpToIAST,
-			iastToUAST,
-			builderFuncs[sa][hu],
-			builderFuncs[sa][df],
-		},
-		"gu": []func(string) string{
-			slpToIAST,
-			iastToUAST,
-			builderFuncs[gu][hu],
-			builderFuncs[gu][df],
-		},
-		"or": []func(string) string{
-			slpToIAST,
-			iastToUAST,
-			builderFuncs[or][hu],
-			builderFuncs[or][df],
-		},
-		"kn": []func(string) string{
-			slpToIAST,
-			iastToUAST,
-			builderFuncs[kn][hu],
-			builderFuncs[kn][df],
-		},
-		"ta": []func(string) string{
-			slpToIAST,
-			iastToUAST,
-			builderFuncs[ta][hu],
-			builderFuncs[ta][df],
-		},
-		"te": []func(string) string{
-			slpToIAST,
-			iastToUAST,
-			builderFuncs[te][hu],
-			builderFuncs[te][df],
-		},
-		"ml": []func(string) string{
-			slpToIAST,
-			iastToUAST,
-			builderFuncs[ml][hu],
-			builderFuncs[ml][df],
-		},
-	},
-	"iast": {
-		"uast": []func(string) string{
-			iastToUAST,
-		},
-		"devanāgarī": []func(string) string{
-			iastToUAST,
-			builderFuncs[sa][hu],
-			builderFuncs[sa][df],
-		},
-		"gu": []func(string) string{
-			iastToUAST,
-			builderFuncs[gu][hu],
-			builderFuncs[gu][df],
-		},
-		"or": []func(string) string{
-			iastToUAST,
-			builderFuncs[or][hu],
-			builderFuncs[or][df],
-		},
-		"kn": []func(string) string{
-			iastToUAST,
-			builderFuncs[kn][hu],
-			builderFuncs[kn][df],
-		},
-		"ta": []func(string) string{
-			iastToUAST,
-			builderFuncs[ta][hu],
-			builderFuncs[ta][df],
-		},
-		"te": []func(string) string{
-			iastToUAST,
-			builderFuncs[gu][hu],
-			builderFuncs[gu][df],
-		},
-		"ml": []func(string) string{
-			iastToUAST,
-			builderFuncs[ml][hu],
-			builderFuncs[ml][df],
-		},
-	},
-	"gu": {
-		"devanāgarī": []func(string) string{
-			builderFuncs[gu][sd],
-		},
-		"uast": []func(string) string{
-			builderFuncs[gu][sd],
-			devanāgarīToUAST,
-		},
-		"iast": []func(string) string{
-			builderFuncs[gu][sd],
-			devanāgarīToUAST,
-			builderFuncs[sa][hu],
-			dataToIAST,
-		},
-		"or": []func(string) string{
-			builderFuncs[gu][sd],
-			builderFuncs[or][ds],
-		},
-		"kn": []func(string) string{
-			builderFuncs[gu][sd],
-			builderFuncs[kn][ds],
-		},
-		"ta": []func(string) string{
-			builderFuncs[gu][sd],
-			builderFuncs[ta][ds],
-		},
-		"te": []func(string) string{
-			builderFuncs[gu][sd],
-			builderFuncs[te][ds],
-		},
-		"ml": []func(string) string{
-			builderFuncs[gu][sd],
-			builderFuncs[ml][ds],
-		},
-	},
-	"or": {
-		"devanāgarī": []func(string) string{
-			builderFuncs[or][sd],
-		},
-		"uast": []func(string) string{
-			builderFuncs[or][sd],
-			devanāgarīToUAST,
-		},
-		"iast": []func(string) string{
-			builderFuncs[or][sd],
-			devanāgarīToUAST,
-			builderFuncs[sa][hu],
-			dataToIAST,
-		},
-		"gu": []func(string) string{
-			builderFuncs[or][sd],
-			builderFuncs[gu][ds],
-		},
-		"kn": []func(string) string{
-			builderFuncs[or][sd],
-			builderFuncs[kn][sd],
-		},
-		"ta": []func(string) string{
-			builderFuncs[or][sd],
-			builderFuncs[ta][ds],
-		},
-		"te": []func(string) string{
-			builderFuncs[or][sd],
-			builderFuncs[te][ds],
-		},
-		"ml": []func(string) string{
-			builderFuncs[or][sd],
-			builderFuncs[ml][ds],
-		},
-	},
-	"kn": {
-		"devanāgarī": []func(string) string{
-			builderFuncs[kn][sd],
-		},
-		"uast": []func(string) string{
-			builderFuncs[kn][sd],
-			devanāgarīToUAST,
-		},
-		"iast": []func(string) string{
-			builderFuncs[kn][sd],
-			devanāgarīToUAST,
-			builderFuncs[sa][hu],
-			dataToIAST,
-		},
-		"or": []func(string) string{
-			builderFuncs[kn][sd],
-			builderFuncs[or][ds],
-		},
-		"gu": []func(string) string{
-			builderFuncs[kn][sd],
-			builderFuncs[gu][ds],
-		},
-		"ta": []func(string) string{
-			builderFuncs[kn][sd],
-			builderFuncs[ta][ds],
-		},
-		"te": []func(string) string{
-			builderFuncs[kn][sd],
-			builderFuncs[te][ds],
-		},
-		"ml": []func(string) string{
-			builderFuncs[kn][sd],
-			builderFuncs[ml][ds],
-		},
-	},
-	"te": {
-		"devanāgarī": []func(string) string{
-			builderFuncs[te][sd],
-		},
-		"uast": []func(string) string{
-			builderFuncs[te][sd],
-			devanāgarīToUAST,
-		},
-		"iast": []func(string) string{
-			builderFuncs[te][sd],
-			devanāgarīToUAST,
-			builderFuncs[sa][hu],
-			dataToIAST,
-		},
-		"or": []func(string) string{
-			builderFuncs[te][sd],
-			builderFuncs[or][ds],
-		},
-		"kn": []func(string) string{
-			builderFuncs[te][sd],
-			builderFuncs[kn][ds],
-		},
-		"ta": []func(string) string{
-			builderFuncs[te][sd],
-			builderFuncs[ta][ds],
-		},
-		"gu": []func(string) string{
-			builderFuncs[te][sd],
-			builderFuncs[gu][ds],
-		},
-		"ml": []func(string) string{
-			builderFuncs[te][sd],
-			builderFuncs[ml][ds],
-		},
-	},
-	"ta": {
-		"devanāgarī": []func(string) string{
-			builderFuncs[ta][sd],
-		},
-		"uast": []func(string) string{
-			builderFuncs[ta][sd],
-			devanāgarīToUAST,
-		},
-		"iast": []func(string) string{
-			builderFuncs[ta][sd],
-			devanāgarīToUAST,
-			builderFuncs[sa][hu],
-			dataToIAST,
-		},
-		"or": []func(string) string{
-			builderFuncs[ta][sd],
-			builderFuncs[or][ds],
-		},
-		"kn": []func(string) string{
-			builderFuncs[ta][sd],
-			builderFuncs[kn][ds],
-		},
-		"gu": []func(string) string{
-			builderFuncs[ta][sd],
-			builderFuncs[gu][ds],
-		},
-		"te": []func(string) string{
-			builderFuncs[ta][sd],
-			builderFuncs[te][ds],
-		},
-		"ml": []func(string) string{
-			builderFuncs[ta][sd],
-			builderFuncs[ml][ds],
-		},
-	},
-	"ml": {
-		"devanāgarī": []func(string) string{
-			builderFuncs[ml][sd],
-		},
-		"uast": []func(string) string{
-			builderFuncs[ml][sd],
-			devanāgarīToUAST,
-		},
-		"iast": []func(string) string{
-			builderFuncs[ml][sd],
-			devanāgarīToUAST,
-			builderFuncs[sa][hu],
-			dataToIAST,
-		},
-		"or": []func(string) string{
-			builderFuncs[ml][sd],
-			builderFuncs[or][ds],
-		},
-		"kn": []func(string) string{
-			builderFuncs[ml][sd],
-			builderFuncs[kn][ds],
-		},
-		"ta": []func(string) string{
-			builderFuncs[ml][sd],
-			builderFuncs[ta][ds],
-		},
-		"te": []func(string) string{
-			builderFuncs[ml][sd],
-			builderFuncs[te][ds],
-		},
-		"gu": []func(string) string{
-			builderFuncs[ml][sd],
-			builderFuncs[gu][ds],
-		},
-	},
+func createBuilder(lang langList) map[funcList]func(string) string {
+	return map[funcList]func(string) string{
+		df: createDataFunction(lang),
+		hu: createHandleUnicode(lang),
+		sd: createScriptFunction(lang),
+		ds: createDevanāgarīToScript(lang),
+	}
 }
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"slices"
 	"strings"
 	"testing"
 )
@@ -21,11 +22,7 @@ func TestHandleUnicode(t *testing.T) {
 	}
 	for _, tC := range testCases {
 		t.Run("__"+tC.input+"__", func(t *testing.T) {
-			if k, ok := Convertors["uast-io"]["iast"]; ok {
-				for _, f := range k {
-					tC.input = f(tC.input)
-				}
-			}
+			tC.input = convert(t, "uast-io", "iast", tC.input)
 
 			if tC.input != tC.output {
 				t.Fail()
@@ -90,11 +87,7 @@ func TestUASTToIAST(t *testing.T) {
 	}
 	for _, tC := range testCases {
 		t.Run("__"+tC.input+"__", func(t *testing.T) {
-			if k, ok := Convertors["uast"]["iast"]; ok {
-				for _, f := range k {
-					tC.input = f(tC.input)
-				}
-			}
+			tC.input = convert(t, "uast", "iast", tC.input)
 
 			if tC.input != tC.output {
 				t.Fail()
@@ -125,15 +118,10 @@ func TestDevanāgarīToIAST(t *testing.T) {
 	for _, tC := range testCases {
 		t.Run("__"+tC.input+"__", func(t *testing.T) {
 			l := strings.Split(tC.input, " ")
-			m := Convertors["devanāgarī"]["iast"]
 			o := []string{}
 
 			for _, i := range l {
-				for _, f := range m {
-					i = f(i)
-				}
-
-				o = append(o, i)
+				o = append(o, convert(t, "devanāgarī", "iast", i))
 			}
 
 			if strings.Join(o, " ") != tC.output {
@@ -142,3 +130,1552 @@ func TestDevanāgarīToIAST(t *testing.T) {
 		})
 	}
 }
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		scheme string
+		input  string
+		output []Unmapped
+	}{
+		{
+			scheme: "devanāgarī",
+			input:  "धर्मक्षेत्रे कुरुक्षेत्रे",
+			output: nil,
+		},
+		{
+			scheme: "devanāgarī",
+			input:  "धर्म\nकxyरु Q",
+			output: []Unmapped{
+				{Text: "xy", Line: 2, Column: 2, Offset: 16},
+				{Text: "Q", Line: 2, Column: 7, Offset: 25},
+			},
+		},
+		{
+			scheme: "uast",
+			input:  "/d/h/q/a",
+			output: []Unmapped{
+				{Text: "/q/", Line: 1, Column: 5, Offset: 4},
+			},
+		},
+		{
+			scheme: "hk",
+			input:  "rAmaH, 108 (iti)?",
+			output: nil,
+		},
+		{
+			scheme: "itrans",
+			input:  "raamaH, 108 (iti)?",
+			output: nil,
+		},
+		{
+			scheme: "wx",
+			input:  "rAmaH, 108 (IlYe)?",
+			output: nil,
+		},
+		{
+			scheme: "hk",
+			input:  "agním ILe deváu",
+			output: nil,
+		},
+		{
+			scheme: "velthuis",
+			input:  "agním iiLe deváu",
+			output: nil,
+		},
+		{
+			scheme: "wx",
+			input:  "agním IlYe xevÓ",
+			output: nil,
+		},
+		{
+			scheme: "cyrl",
+			input:  "ра̄мах̣, 108 (ити)?",
+			output: nil,
+		},
+		{
+			scheme: "krutidev",
+			input:  "Je 108 (Je)!",
+			output: nil,
+		},
+		{
+			scheme: "slp",
+			input:  "rAmaH. 108 (iti)..",
+			output: nil,
+		},
+		{
+			scheme: "slp",
+			input:  "rAmaH;",
+			output: []Unmapped{
+				{Text: ";", Line: 1, Column: 6, Offset: 5},
+			},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.input+"__", func(t *testing.T) {
+			if v := Check(tC.scheme, tC.input); !slices.Equal(v, tC.output) {
+				t.Errorf("got %v, want %v", v, tC.output)
+			}
+		})
+	}
+}
+
+// Convert s along the route between two schemes, failing the test if there
+// is none
+func convert(t *testing.T, from, to, s string) string {
+	t.Helper()
+
+	k, ok := Route(from, to)
+	if !ok {
+		t.Fatalf("no route from %v to %v", from, to)
+	}
+	for _, f := range k {
+		s = f(s)
+	}
+
+	return s
+}
+
+func TestRoute(t *testing.T) {
+	for _, from := range Sources() {
+		for _, to := range Targets() {
+			if _, ok := Route(from, to); !ok {
+				t.Errorf("no route from %v to %v", from, to)
+			}
+		}
+	}
+
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   "iast",
+			to:     "te",
+			input:  "kṛṣṇa",
+			output: "కృష్ణ",
+		},
+		{
+			from:   "or",
+			to:     "kn",
+			input:  "କୃଷ୍ଣ",
+			output: "ಕೃಷ್ಣ",
+		},
+		{
+			from:   "uast-io",
+			to:     "gu",
+			input:  "/om/",
+			output: "ૐ",
+		},
+		{
+			from:   "hk",
+			to:     "devanāgarī",
+			input:  "sat,(vAk)?",
+			output: "सत्,(वाक्)?",
+		},
+		{
+			from:   "cyrl",
+			to:     "devanāgarī",
+			input:  "Ра̄ма",
+			output: "राम",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.to+"__", func(t *testing.T) {
+			tC.input = convert(t, tC.from, tC.to, tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestLoadSchemeDir(t *testing.T) {
+	names, err := LoadSchemeDir("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Contains(names, "te-dirgha") {
+		t.Fatalf("got %v", names)
+	}
+
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   "iast",
+			to:     "te-dirgha",
+			input:  "devo",
+			output: "దేవో",
+		},
+		{
+			from:   "te-dirgha",
+			to:     "devanāgarī",
+			input:  "దేవో",
+			output: "देवो",
+		},
+		{
+			from:   "te-dirgha",
+			to:     "te",
+			input:  "ఏకః",
+			output: "ఎకః",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.to+"__", func(t *testing.T) {
+			tC.input = convert(t, tC.from, tC.to, tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestRegisterSchemeErrors(t *testing.T) {
+	for _, s := range []SchemeFile{
+		{},
+		{Name: "iast"},
+		{Name: "sa"},
+		{Name: "x", Base: "y"},
+		{Name: "x", Consonants: charMap{"q": "q"}},
+		{Name: "x", Misc: charMap{"|": "|"}},
+	} {
+		if err := RegisterScheme(s); err == nil {
+			t.Errorf("expected error for %v", s)
+		}
+	}
+}
+
+func TestHK(t *testing.T) {
+	testCases := []struct {
+		hk   string
+		iast string
+	}{
+		{
+			hk:   "saMskRtam",
+			iast: "saṃskṛtam",
+		},
+		{
+			hk:   "dharmakSetre",
+			iast: "dharmakṣetre",
+		},
+		{
+			hk:   "lRkAraH",
+			iast: "ḷkāraḥ",
+		},
+		{
+			hk:   "zivo'ham",
+			iast: "śivo'ham",
+		},
+		{
+			hk:   "gaGgAjJAnam",
+			iast: "gaṅgājñānam",
+		},
+		{
+			hk:   "rAmaH, 108 (iti)?",
+			iast: "rāmaḥ, 108 (iti)?",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.hk+"__", func(t *testing.T) {
+			if v := hkToIAST(tC.hk); v != tC.iast {
+				t.Errorf("got %q, want %q", v, tC.iast)
+			}
+
+			if v := iastToHK(tC.iast); v != tC.hk {
+				t.Errorf("got %q, want %q", v, tC.hk)
+			}
+		})
+	}
+
+	// Harvard-Kyoto has no case, and its capitals are other letters
+	if s := convert(t, "iast", "hk", "Rāmaḥ Kṛṣṇaḥ"); s != "rAmaH kRSNaH" {
+		t.Errorf("got %q, want %q", s, "rAmaH kRSNaH")
+	}
+	if s := convert(t, "hk", "iast", "rAmaH kRSNaH"); s != "rāmaḥ kṛṣṇaḥ" {
+		t.Errorf("got %q, want %q", s, "rāmaḥ kṛṣṇaḥ")
+	}
+}
+
+func TestITRANS(t *testing.T) {
+	testCases := []struct {
+		input  string
+		iast   string
+		output string
+	}{
+		{
+			input:  "saMskRRitam",
+			iast:   "saṃskṛtam",
+			output: "saMskRRitam",
+		},
+		{
+			input:  "kR^iShNa",
+			iast:   "kṛṣṇa",
+			output: "kRRiShNa",
+		},
+		{
+			input:  "dharmaxetre",
+			iast:   "dharmakṣetre",
+			output: "dharmakShetre",
+		},
+		{
+			input:  "GYAnam",
+			iast:   "jñānam",
+			output: "j~naanam",
+		},
+		{
+			input:  "ga.ngA",
+			iast:   "gaṃgā",
+			output: "gaMgaa",
+		},
+		{
+			input:  "chhAyA",
+			iast:   "chāyā",
+			output: "Chaayaa",
+		},
+		{
+			input:  "so.aham",
+			iast:   "so'ham",
+			output: "so.aham",
+		},
+		{
+			input:  "vAk.h",
+			iast:   "vāk",
+			output: "vaak",
+		},
+		{
+			input:  "devA.N",
+			iast:   "devāã",
+			output: "devaa.N",
+		},
+		{
+			input:  "rAmaH, 108 (iti)?",
+			iast:   "rāmaḥ, 108 (iti)?",
+			output: "raamaH, 108 (iti)?",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.input+"__", func(t *testing.T) {
+			if v := itransToIAST(tC.input); v != tC.iast {
+				t.Errorf("got %q, want %q", v, tC.iast)
+			}
+
+			if v := iastToITRANS(tC.iast); v != tC.output {
+				t.Errorf("got %q, want %q", v, tC.output)
+			}
+		})
+	}
+}
+
+func TestSLP(t *testing.T) {
+	testCases := []struct {
+		slp  string
+		iast string
+	}{
+		{
+			slp:  "saMskftam",
+			iast: "saṃskṛtam",
+		},
+		{
+			slp:  "Darmakzetre",
+			iast: "dharmakṣetre",
+		},
+		{
+			slp:  "xkAraH",
+			iast: "ḷkāraḥ",
+		},
+		{
+			slp:  "SEvO",
+			iast: "śaivau",
+		},
+		{
+			slp:  "agnimILe",
+			iast: "agnimīḻe",
+		},
+		{
+			slp:  "mI|us",
+			iast: "mīḻhus",
+		},
+		{
+			slp:  "devA~",
+			iast: "devāã",
+		},
+		{
+			slp:  "so'ham",
+			iast: "so'ham",
+		},
+		{
+			slp:  "sat, vAk? (12)",
+			iast: "sat, vāk? (12)",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.slp+"__", func(t *testing.T) {
+			if v := iastToSLP(tC.iast); v != tC.slp {
+				t.Errorf("got %q, want %q", v, tC.slp)
+			}
+
+			if v := slpToIAST(tC.slp); v != tC.iast {
+				t.Errorf("got %q, want %q", v, tC.iast)
+			}
+		})
+	}
+
+	for _, from := range []string{"devanāgarī", "gu", "kn", "uast"} {
+		s := convert(t, from, "slp", map[string]string{
+			"devanāgarī": "कृष्णः",
+			"gu":         "કૃષ્ણઃ",
+			"kn":         "ಕೃಷ್ಣಃ",
+			"uast":       "k/r//sl/-/nl//h/",
+		}[from])
+
+		if s != "kfzRaH" {
+			t.Errorf("%v: got %q", from, s)
+		}
+	}
+
+	// SLP1 has no case, and its capitals are other letters
+	if s := convert(t, "iast", "slp", "Rāmo Devīm"); s != "rAmo devIm" {
+		t.Errorf("got %q, want %q", s, "rAmo devIm")
+	}
+	if s := convert(t, "slp", "iast", "rAmo devIm"); s != "rāmo devīm" {
+		t.Errorf("got %q, want %q", s, "rāmo devīm")
+	}
+}
+
+func TestVelthuisAndWX(t *testing.T) {
+	testCases := []struct {
+		iast     string
+		velthuis string
+		wx       string
+	}{
+		{
+			iast:     "saṃskṛtam",
+			velthuis: "sa.msk.rtam",
+			wx:       "saMskqwam",
+		},
+		{
+			iast:     "dharmakṣetre",
+			velthuis: "dharmak.setre",
+			wx:       "XarmakRewre",
+		},
+		{
+			iast:     "śaṅkaraḥ",
+			velthuis: "\"sa\"nkara.h",
+			wx:       "SafkaraH",
+		},
+		{
+			iast:     "jñānam",
+			velthuis: "j~naanam",
+			wx:       "jFAnam",
+		},
+		{
+			iast:     "ḍhaukate",
+			velthuis: ".dhaukate",
+			wx:       "DOkawe",
+		},
+		{
+			iast:     "devāã",
+			velthuis: "devaa/",
+			wx:       "xevAz",
+		},
+		{
+			iast:     "īḻe",
+			velthuis: "iiLe",
+			wx:       "IlYe",
+		},
+		{
+			iast:     "rāmaḥ, 108 (iti)?",
+			velthuis: "raama.h, 108 (iti)?",
+			wx:       "rAmaH, 108 (iwi)?",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.iast+"__", func(t *testing.T) {
+			if v := iastToVelthuis(tC.iast); v != tC.velthuis {
+				t.Errorf("got %q, want %q", v, tC.velthuis)
+			}
+
+			if v := velthuisToIAST(tC.velthuis); v != tC.iast {
+				t.Errorf("got %q, want %q", v, tC.iast)
+			}
+
+			if v := iastToWX(tC.iast); v != tC.wx {
+				t.Errorf("got %q, want %q", v, tC.wx)
+			}
+
+			if v := wxToIAST(tC.wx); v != tC.iast {
+				t.Errorf("got %q, want %q", v, tC.iast)
+			}
+		})
+	}
+
+	// WX has no case, and its capitals are other letters
+	if s := convert(t, "iast", "wx", "Rāmaḥ"); s != "rAmaH" {
+		t.Errorf("got %q, want %q", s, "rAmaH")
+	}
+	if s := convert(t, "wx", "iast", "rAmaH"); s != "rāmaḥ" {
+		t.Errorf("got %q, want %q", s, "rāmaḥ")
+	}
+}
+
+func TestISO(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   "devanāgarī",
+			to:     "iso",
+			input:  "संस्कृतं",
+			output: "saṁskr̥taṁ",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "iso",
+			input:  "अग्निमीळे",
+			output: "agnimīḷē",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "iso",
+			input:  "देवाँ",
+			output: "dēvām̐",
+		},
+		{
+			from:   "iast",
+			to:     "iso",
+			input:  "Eka",
+			output: "Ēka",
+		},
+		{
+			from:   "iso",
+			to:     "devanāgarī",
+			input:  "Ēka",
+			output: "एक",
+		},
+		{
+			from:   "iso",
+			to:     "devanāgarī",
+			input:  "Eka",
+			output: "ऎक",
+		},
+		{
+			from:   "iso",
+			to:     "iast",
+			input:  "R̥ṣiḥ",
+			output: "Ṛṣiḥ",
+		},
+		{
+			from:   "iast",
+			to:     "iso",
+			input:  "Ḷkāra",
+			output: "L̥kāra",
+		},
+		{
+			from:   "kn-long",
+			to:     "iso",
+			input:  "ಕೆರೆ",
+			output: "kere",
+		},
+		{
+			from:   "ml-long",
+			to:     "iso",
+			input:  "കേരളം",
+			output: "kēraḷaṁ",
+		},
+		{
+			from:   "te-long",
+			to:     "iso",
+			input:  "మొదలు",
+			output: "modalu",
+		},
+		{
+			from:   "iso",
+			to:     "kn-long",
+			input:  "kēraḷa",
+			output: "ಕೇರಳ",
+		},
+		{
+			from:   "iso",
+			to:     "kn-long",
+			input:  "kere",
+			output: "ಕೆರೆ",
+		},
+		{
+			from:   "iso",
+			to:     "devanāgarī",
+			input:  "r̥̄",
+			output: "ॠ",
+		},
+		{
+			from:   "kn",
+			to:     "gu",
+			input:  "ಕೆರೆ",
+			output: "કેરે",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.input+"__", func(t *testing.T) {
+			tC.input = convert(t, tC.from, tC.to, tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestBengali(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   "devanāgarī",
+			to:     "bn",
+			input:  "धर्मक्षेत्रे",
+			output: "ধর্মক্ষেত্রে",
+		},
+		{
+			from:   "iast",
+			to:     "bn",
+			input:  "viṣṇuḥ",
+			output: "বিষ্ণুঃ",
+		},
+		{
+			from:   "bn",
+			to:     "devanāgarī",
+			input:  "সৎ",
+			output: "सत्",
+		},
+		{
+			from:   "iast",
+			to:     "as",
+			input:  "viṣṇuḥ",
+			output: "ৱিষ্ণুঃ",
+		},
+		{
+			from:   "as",
+			to:     "iast",
+			input:  "ৰামঃ",
+			output: "rāmaḥ",
+		},
+		{
+			from:   "as",
+			to:     "bn",
+			input:  "ৰামঃ",
+			output: "রামঃ",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.input+"__", func(t *testing.T) {
+			tC.input = convert(t, tC.from, tC.to, tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestGurmukhi(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   "iast",
+			to:     "pa",
+			input:  "kṛṣṇaḥ",
+			output: "ਕ੍ਰਿਸ਼੍ਣਃ",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "pa",
+			input:  "ऋषिः",
+			output: "ਰਿਸ਼ਿਃ",
+		},
+		{
+			from:   "iast",
+			to:     "pa",
+			input:  "kḷptam",
+			output: "ਕ੍ਲ੍ਰਿਪ੍ਤਮ੍",
+		},
+		{
+			from:   "pa",
+			to:     "devanāgarī",
+			input:  "ਸ਼ਿਵਃ",
+			output: "शिवः",
+		},
+		{
+			from:   "pa",
+			to:     "iast",
+			input:  "ਕ੍ਰਿਸ਼੍ਣਃ",
+			output: "kriśṇaḥ",
+		},
+		{
+			from:   "pa",
+			to:     "iast",
+			input:  "ਮੰਗਲ਼",
+			output: "maṃgaḻa",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.input+"__", func(t *testing.T) {
+			tC.input = convert(t, tC.from, tC.to, tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestLossy(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output []Loss
+	}{
+		{
+			from:  "iast",
+			to:    "pa",
+			input: "kṛṣṇaḥ ṛṣiḥ",
+			output: []Loss{
+				{Letter: "ṛ", Written: "ਰਿ", Count: 2},
+				{Letter: "ṣ", Written: "ਸ਼", Count: 2},
+			},
+		},
+		{
+			from:  "devanāgarī",
+			to:    "bn",
+			input: "विष्णुः",
+			output: []Loss{
+				{Letter: "v", Written: "ব", Count: 1},
+			},
+		},
+		{
+			from:  "kn-long",
+			to:    "gu",
+			input: "ಕೆರೆ",
+			output: []Loss{
+				{Letter: "ĕ", Written: "એ", Count: 2},
+			},
+		},
+		{
+			from:  "iso",
+			to:    "te",
+			input: "kere",
+			output: []Loss{
+				{Letter: "ĕ", Written: "ఎ", Count: 2},
+			},
+		},
+		{
+			from:  "iast",
+			to:    "taml",
+			input: "saṃskṛtam",
+			output: []Loss{
+				{Letter: "ṃ", Written: "ம்", Count: 1},
+			},
+		},
+		{
+			from:  "iast",
+			to:    "braille",
+			input: "so'ham",
+			output: []Loss{
+				{Letter: "'", Written: "", Count: 1},
+			},
+		},
+		{
+			from:  "iast",
+			to:    "braille",
+			input: "agním īḻe",
+			output: []Loss{
+				{Letter: "\u0301", Written: "", Count: 1},
+			},
+		},
+		{
+			from:  "iast",
+			to:    "hk",
+			input: "sagͫskṛtam antaẖkaraṇam vĕda",
+			output: []Loss{
+				{Letter: "gͫ", Written: "M", Count: 1},
+				{Letter: "ẖ", Written: "H", Count: 1},
+				{Letter: "ĕ", Written: "e", Count: 1},
+			},
+		},
+		{
+			from:  "iast",
+			to:    "iso",
+			input: "sagͫskṛtam antaẖkaraṇam",
+			output: []Loss{
+				{Letter: "gͫ", Written: "ṁ", Count: 1},
+			},
+		},
+		{
+			from:  "iast",
+			to:    "cyrl",
+			input: "punaḫpunaḥ vĕda",
+			output: []Loss{
+				{Letter: "ḫ", Written: "х\u0323", Count: 1},
+			},
+		},
+		{
+			from:  "devanāgarī",
+			to:    "iscii",
+			input: "रामः॥ अ॒ग्निमी॑ळे",
+			output: []Loss{
+				{Letter: "\u0300", Written: "", Count: 1},
+				{Letter: "\u0301", Written: "", Count: 1},
+			},
+		},
+		{
+			from:   "iast",
+			to:     "pa",
+			input:  "śivaḥ",
+			output: nil,
+		},
+		{
+			from:   "iast",
+			to:     "hk",
+			input:  "kṛṣṇaḥ",
+			output: nil,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.to+"_"+tC.input+"__", func(t *testing.T) {
+			if v := Lossy(tC.from, tC.to, tC.input); !slices.Equal(v, tC.output) {
+				t.Errorf("got %v, want %v", v, tC.output)
+			}
+		})
+	}
+}
+
+func TestSinhala(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   "iast",
+			to:     "si",
+			input:  "buddhaḥ",
+			output: "බුද්ධඃ",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "si",
+			input:  "धर्मक्षेत्रे",
+			output: "ධර්මක්ෂේත්‍රේ",
+		},
+		{
+			from:   "iast",
+			to:     "si",
+			input:  "ṛṣiḥ",
+			output: "ඍෂිඃ",
+		},
+		{
+			from:   "iast",
+			to:     "si",
+			input:  "śrī",
+			output: "ශ්‍රී",
+		},
+		{
+			from:   "si",
+			to:     "devanāgarī",
+			input:  "ශ්‍රී",
+			output: "श्री",
+		},
+		{
+			from:   "iast",
+			to:     "si",
+			input:  "vākya",
+			output: "වාක්‍ය",
+		},
+		{
+			from:   "si",
+			to:     "devanāgarī",
+			input:  "ප්රජ්ඤාපාරමිතා",
+			output: "प्रज्ञापारमिता",
+		},
+		{
+			from:   "si",
+			to:     "iast",
+			input:  "කොණ්ඩ",
+			output: "kŏṇḍa",
+		},
+		{
+			from:   "si",
+			to:     "kn",
+			input:  "ගෝපාලඃ",
+			output: "ಗೊಪಾಲಃ",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.input+"__", func(t *testing.T) {
+			tC.input = convert(t, tC.from, tC.to, tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestHistoricScripts(t *testing.T) {
+	testCases := []struct {
+		to     string
+		input  string
+		output string
+	}{
+		{
+			to:     "brah",
+			input:  "dharmakṣetre",
+			output: "𑀥𑀭𑁆𑀫𑀓𑁆𑀱𑁂𑀢𑁆𑀭𑁂",
+		},
+		{
+			to:     "shrd",
+			input:  "kurukṣetre",
+			output: "𑆑𑆶𑆫𑆶𑆑𑇀𑆰𑆼𑆠𑇀𑆫𑆼",
+		},
+		{
+			to:     "sidd",
+			input:  "kḷptam",
+			output: "𑖎𑖿𑖈𑖢𑖿𑖝𑖦𑖿",
+		},
+		{
+			to:     "newa",
+			input:  "108",
+			output: "𑑑𑑐𑑘",
+		},
+		{
+			to:     "gran",
+			input:  "kauśikaḥ",
+			output: "𑌕𑍌𑌶𑌿𑌕𑌃",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.to+"_"+tC.input+"__", func(t *testing.T) {
+			v := convert(t, "iast", tC.to, tC.input)
+
+			if v != tC.output {
+				t.Errorf("got %q, want %q", v, tC.output)
+			}
+
+			v = convert(t, tC.to, "iast", v)
+
+			if v != tC.input {
+				t.Errorf("got %q, want %q", v, tC.input)
+			}
+		})
+	}
+}
+
+func TestNativeTamil(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   "iast",
+			to:     "taml",
+			input:  "dharmakṣetre",
+			output: "த⁴ர்மக்ஷேத்ரே",
+		},
+		{
+			from:   "iast",
+			to:     "taml",
+			input:  "gauḍaḥ",
+			output: "கௌ³ட³ஃ",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "taml",
+			input:  "ब्रह्म",
+			output: "ப்³ரஹ்ம",
+		},
+		{
+			from:   "iast",
+			to:     "taml",
+			input:  "kṛṣṇaḥ",
+			output: "க்ருʼஷ்ணஃ",
+		},
+		{
+			from:   "taml",
+			to:     "iast",
+			input:  "பா⁴ரதம்",
+			output: "bhāratam",
+		},
+		{
+			from:   "taml",
+			to:     "devanāgarī",
+			input:  "ஜ⁴ஷஃ",
+			output: "झषः",
+		},
+		{
+			from:   "taml",
+			to:     "uast",
+			input:  "க்ருʼஷ்ணஃ",
+			output: "k/r//sl/-/nl//h/",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.input+"__", func(t *testing.T) {
+			tC.input = convert(t, tC.from, tC.to, tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestSoutheastAsianScripts(t *testing.T) {
+	testCases := []struct {
+		to     string
+		input  string
+		output string
+	}{
+		{
+			to:     "thai",
+			input:  "kṣetre",
+			output: "เกฺษเตฺร",
+		},
+		{
+			to:     "thai",
+			input:  "aiśvaryam",
+			output: "ไอศฺวรฺยมฺ",
+		},
+		{
+			to:     "khmr",
+			input:  "hrasvaḥ",
+			output: "ហ្រស្វះ",
+		},
+		{
+			to:     "khmr",
+			input:  "oṣadhiḥ",
+			output: "ឱឞធិះ",
+		},
+		{
+			to:     "bali",
+			input:  "dharmaḥ",
+			output: "ᬥᬭ᭄ᬫᬄ",
+		},
+		{
+			to:     "bali",
+			input:  "eṣaḥ",
+			output: "ᬏᬱᬄ",
+		},
+		{
+			to:     "java",
+			input:  "satyam",
+			output: "ꦱꦠ꧀ꦪꦩ꧀",
+		},
+		{
+			to:     "java",
+			input:  "āditya",
+			output: "ꦄꦴꦢꦶꦠ꧀ꦪ",
+		},
+		{
+			to:     "mymr",
+			input:  "vākyam",
+			output: "ဝာကျမ်",
+		},
+		{
+			to:     "mymr",
+			input:  "kṛṣṇaḥ",
+			output: "ကၖၑ္ဏး",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.to+"_"+tC.input+"__", func(t *testing.T) {
+			v := convert(t, "iast", tC.to, tC.input)
+
+			if v != tC.output {
+				t.Errorf("got %q, want %q", v, tC.output)
+			}
+
+			v = convert(t, tC.to, "iast", v)
+
+			if v != tC.input {
+				t.Errorf("got %q, want %q", v, tC.input)
+			}
+		})
+	}
+}
+
+func TestBraille(t *testing.T) {
+	testCases := []struct {
+		iast    string
+		braille string
+	}{
+		{
+			iast:    "gaṇeśaḥ",
+			braille: "⠛⠼⠑⠩⠠",
+		},
+		{
+			iast:    "dharmakṣetre",
+			braille: "⠮⠗⠈⠍⠅⠈⠯⠑⠞⠈⠗⠑",
+		},
+		{
+			iast:    "āditya",
+			braille: "⠜⠙⠊⠞⠈⠽",
+		},
+		{
+			iast:    "kṛṣṇaḥ",
+			braille: "⠅⠐⠗⠯⠈⠼⠠",
+		},
+		{
+			iast:    "108",
+			braille: "⠼⠁⠚⠓",
+		},
+		{
+			iast:    "..12..",
+			braille: "⠲⠲⠼⠁⠃⠲⠲",
+		},
+		{
+			iast:    "12.",
+			braille: "⠼⠁⠃⠲",
+		},
+		{
+			iast:    "gaṇeśaḥ.",
+			braille: "⠛⠼⠑⠩⠠⠲",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.iast+"__", func(t *testing.T) {
+			v := convert(t, "iast", "braille", tC.iast)
+
+			if v != tC.braille {
+				t.Errorf("got %q, want %q", v, tC.braille)
+			}
+
+			v = convert(t, "braille", "iast", tC.braille)
+
+			if v != tC.iast {
+				t.Errorf("got %q, want %q", v, tC.iast)
+			}
+		})
+	}
+
+	if v := convert(t, "devanāgarī", "braille", "॥१२॥"); v != "⠲⠲⠼⠁⠃⠲⠲" {
+		t.Errorf("got %q, want %q", v, "⠲⠲⠼⠁⠃⠲⠲")
+	}
+	if v := convert(t, "braille", "devanāgarī", "⠲⠲⠼⠁⠃⠲⠲"); v != "॥१२॥" {
+		t.Errorf("got %q, want %q", v, "॥१२॥")
+	}
+}
+
+func TestIPA(t *testing.T) {
+	testCases := []struct {
+		vedic  bool
+		echo   bool
+		input  string
+		output string
+	}{
+		{
+			input:  "saṃskṛtam",
+			output: "sɐ̃skr̩t̪ɐm",
+		},
+		{
+			input:  "saṅgaḥ",
+			output: "sɐŋɡɐh",
+		},
+		{
+			input:  "śaṃkaraḥ",
+			output: "ɕɐŋkɐɾɐh",
+		},
+		{
+			vedic:  true,
+			input:  "śaṃkaraḥ",
+			output: "ɕɐ̃kɐɾɐh",
+		},
+		{
+			vedic:  true,
+			input:  "agnímīḷe devám",
+			output: "ɐɡn̪i\u0301miːl̩eː d̪eːʋɐ\u0301m",
+		},
+		{
+			input:  "caitram",
+			output: "t͡ɕɐit̪ɾɐm",
+		},
+		{
+			vedic:  true,
+			input:  "caitram",
+			output: "caːit̪ɾɐm",
+		},
+		{
+			vedic:  true,
+			input:  "punaḥpunaḥ",
+			output: "pun̪ɐɸpun̪ɐh",
+		},
+		{
+			echo:   true,
+			input:  "hariḥ",
+			output: "ɦɐɾihi",
+		},
+		{
+			echo:   true,
+			input:  "rāmaḥ.",
+			output: "ɾaːmɐhɐ|",
+		},
+		{
+			echo:   true,
+			input:  "hariḥ,",
+			output: "ɦɐɾihi,",
+		},
+		{
+			echo:   true,
+			input:  "hariḥ..",
+			output: "ɦɐɾihi‖",
+		},
+		{
+			echo:   true,
+			input:  "rāmaḥ-kṛṣṇaḥ",
+			output: "ɾaːmɐh-kr̩ʂɳɐhɐ",
+		},
+		{
+			input:  "devāã",
+			output: "d̪eːʋa\u0303ː",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.input+"__", func(t *testing.T) {
+			if v := IPA(tC.vedic, tC.echo)(tC.input); v != tC.output {
+				t.Errorf("got %q, want %q", v, tC.output)
+			}
+		})
+	}
+}
+
+func TestCyrillic(t *testing.T) {
+	testCases := []struct {
+		cyrl string
+		iast string
+	}{
+		{
+			cyrl: "сам̣скр̣там",
+			iast: "saṃskṛtam",
+		},
+		{
+			cyrl: "кр̣шн̣ах̣",
+			iast: "kṛṣṇaḥ",
+		},
+		{
+			cyrl: "джн̃а̄нам",
+			iast: "jñānam",
+		},
+		{
+			cyrl: "ш́ивах̣",
+			iast: "śivaḥ",
+		},
+		{
+			cyrl: "аиш́варйам",
+			iast: "aiśvaryam",
+		},
+		{
+			cyrl: "ра̄мах̣, 108 (ити)?",
+			iast: "rāmaḥ, 108 (iti)?",
+		},
+		{
+			cyrl: "Ра̄мах̣",
+			iast: "Rāmaḥ",
+		},
+		{
+			cyrl: "Ш́ива",
+			iast: "Śiva",
+		},
+		{
+			cyrl: "Аиш́варйам",
+			iast: "Aiśvaryam",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.iast+"__", func(t *testing.T) {
+			if v := cyrlToIAST(tC.cyrl); v != tC.iast {
+				t.Errorf("got %q, want %q", v, tC.iast)
+			}
+
+			if v := iastToCyrl(tC.iast); v != tC.cyrl {
+				t.Errorf("got %q, want %q", v, tC.cyrl)
+			}
+		})
+	}
+}
+
+func TestKrutiDev(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{
+		{
+			input:  "laLd`re~",
+			output: "संस्कृतम्",
+		},
+		{
+			input:  "/keZ",
+			output: "धर्म",
+		},
+		{
+			input:  "fdz;k",
+			output: "क्रिया",
+		},
+		{
+			input:  "'kfDr",
+			output: "शक्ति",
+		},
+		{
+			input:  "iwf.kZek",
+			output: "पूर्णिमा",
+		},
+		{
+			input:  "vkRek",
+			output: "आत्मा",
+		},
+		{
+			input:  "/keZ{ks=s dq#{ks=s",
+			output: "धर्मक्षेत्रे कुरुक्षेत्रे",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.input+"__", func(t *testing.T) {
+			tC.input = convert(t, "krutidev", "devanāgarī", tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestVedicSounds(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   "iast",
+			to:     "devanāgarī",
+			input:  "tataẖkaroti",
+			output: "ततᳵकरोति",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "iast",
+			input:  "यᳶपिबति",
+			output: "yaḫpibati",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "iast",
+			input:  "नमᳲ",
+			output: "namaᳲ",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "gu",
+			input:  "सᳩ",
+			output: "સᳩ",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "uast",
+			input:  "गृह्णाꣳ",
+			output: "g/r/h-/nl//a//mu/",
+		},
+		{
+			from:   "uast",
+			to:     "devanāgarī",
+			input:  "y/hb/",
+			output: "यᳶ",
+		},
+		{
+			from:   "iast",
+			to:     "gran",
+			input:  "gṛhṇāgͫ",
+			output: "𑌗𑍃𑌹𑍍𑌣𑌾ꣳ",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "iast",
+			input:  "गृह्णाꣳ",
+			output: "gṛhṇāgͫ",
+		},
+		{
+			from:   "iast",
+			to:     "devanāgarī",
+			input:  "ahaṁ",
+			output: "अहं",
+		},
+		{
+			from:   "iast",
+			to:     "gu",
+			input:  "ahaṁ",
+			output: "અહં",
+		},
+		{
+			from:   "iast",
+			to:     "gu",
+			input:  "tataẖ",
+			output: "તતઃ",
+		},
+		{
+			from:   "slp",
+			to:     "devanāgarī",
+			input:  "I|e",
+			output: "ईळ्हे",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "iast",
+			input:  "ईळ्हे",
+			output: "īḻhe",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "iast",
+			input:  "कळह",
+			output: "kaḻaha",
+		},
+		{
+			from:   "devanāgarī",
+			to:     "iast",
+			input:  "कटह",
+			output: "kaṭaha",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.input+"__", func(t *testing.T) {
+			tC.input = convert(t, tC.from, tC.to, tC.input)
+
+			if tC.input != tC.output {
+				t.Errorf("got %q, want %q", tC.input, tC.output)
+			}
+		})
+	}
+}
+
+func TestNasals(t *testing.T) {
+	testCases := []struct {
+		input    string
+		nasal    string
+		anusvāra string
+	}{
+		{
+			input:    "s/m/kl-p",
+			nasal:    "s/nu/-kl-p",
+			anusvāra: "s/m/kl-p",
+		},
+		{
+			input:    "s/n/-jy",
+			nasal:    "s/n/-jy",
+			anusvāra: "s/m/jy",
+		},
+		{
+			input:    "k/m/\\/t/k",
+			nasal:    "k/nl/-\\/t/k",
+			anusvāra: "k/m/\\/t/k",
+		},
+		{
+			input:    "/m/ut-/nl/-/t/h",
+			nasal:    "/m/ut-/nl/-/t/h",
+			anusvāra: "/m/ut-/m//t/h",
+		},
+		{
+			input:    "s/m/s/k/r/t/m-",
+			nasal:    "s/m/s/k/r/t/m-",
+			anusvāra: "s/m/s/k/r/t/m-",
+		},
+		{
+			input:    "a\\n-tr",
+			nasal:    "a\\n-tr",
+			anusvāra: "a\\/m/tr",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.input+"__", func(t *testing.T) {
+			if s := AnusvāraToNasal(tC.input); s != tC.nasal {
+				t.Errorf("got %q, want %q", s, tC.nasal)
+			}
+
+			if s := NasalToAnusvāra(tC.input); s != tC.anusvāra {
+				t.Errorf("got %q, want %q", s, tC.anusvāra)
+			}
+		})
+	}
+}
+
+func TestSyllabify(t *testing.T) {
+	testCases := []struct {
+		input        string
+		orthographic []string
+		phonological []string
+	}{
+		{
+			input:        "dharmakṣetre",
+			orthographic: []string{"dha", "rma", "kṣe", "tre"},
+			phonological: []string{"dhar", "mak", "ṣet", "re"},
+		},
+		{
+			input:        "saṃskṛtam",
+			orthographic: []string{"saṃ", "skṛ", "tam"},
+			phonological: []string{"saṃs", "kṛ", "tam"},
+		},
+		{
+			input:        "yuyutsavaḥ.",
+			orthographic: []string{"yu", "yu", "tsa", "vaḥ"},
+			phonological: []string{"yu", "yut", "sa", "vaḥ"},
+		},
+		{
+			input:        "àgnimī́ḻe",
+			orthographic: []string{"à", "gni", "mī́", "ḻe"},
+			phonological: []string{"àg", "ni", "mī́", "ḻe"},
+		},
+		{
+			input:        "áitareya",
+			orthographic: []string{"ái", "ta", "re", "ya"},
+			phonological: []string{"ái", "ta", "re", "ya"},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.input+"__", func(t *testing.T) {
+			for _, v := range []struct {
+				phonological bool
+				want         []string
+			}{
+				{false, tC.orthographic},
+				{true, tC.phonological},
+			} {
+				var got []string
+				for _, r := range syllabify(tC.input, v.phonological) {
+					got = append(got, tC.input[r[0]:r[1]])
+				}
+
+				if !slices.Equal(got, v.want) {
+					t.Errorf("got %q, want %q", got, v.want)
+				}
+			}
+		})
+	}
+}
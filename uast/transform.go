@@ -0,0 +1,163 @@
+package uast
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aneri0x4f/uast-cli/internal/utils"
+	"golang.org/x/text/transform"
+)
+
+// MaxWordSize is the longest word, in bytes, that a [Transliterator.Transformer]
+// converts at once. Longer runs of text without spaces or newlines are
+// converted in pieces, cut between letters where possible.
+const MaxWordSize = 1024
+
+type transformer struct {
+	t      *Transliterator
+	strict bool
+
+	line   int
+	column int
+	offset int
+
+	// Output of the last word that did not fit in dst
+	pending []byte
+}
+
+// Transformer returns a [transform.Transformer] that converts like
+// [Transliterator.Transliterate], word by word, so that text of any size can
+// be converted in constant memory. Its input should be in NFC, so chain it
+// with [golang.org/x/text/unicode/norm.NFC]:
+//
+//	transform.NewReader(r, transform.Chain(norm.NFC, t.Transformer(), norm.NFC))
+//
+// except on the side of a scheme for which [IsBytes] is true.
+func (t *Transliterator) Transformer() transform.Transformer {
+	return &transformer{t: t, line: 1, column: 1}
+}
+
+// StrictTransformer is like [Transliterator.Transformer] but fails with an
+// [*UnmappedError] at the first word that cannot be mapped.
+func (t *Transliterator) StrictTransformer() transform.Transformer {
+	return &transformer{t: t, strict: true, line: 1, column: 1}
+}
+
+func (tr *transformer) Reset() {
+	tr.line, tr.column, tr.offset = 1, 1, 0
+	tr.pending = nil
+}
+
+// Write as much of the pending output as fits in dst
+func (tr *transformer) flush(dst []byte) (int, error) {
+	n := copy(dst, tr.pending)
+	tr.pending = tr.pending[n:]
+
+	if len(tr.pending) > 0 {
+		return n, transform.ErrShortDst
+	}
+
+	tr.pending = nil
+	return n, nil
+}
+
+// Find where to cut a word longer than MaxWordSize: the last point in the
+// second half of its first MaxWordSize bytes at which converting the two
+// parts apart gives what converting them together does, so that no letter
+// is split. Failing that, it is cut before the last rune start.
+func (tr *transformer) cut(word []byte) int {
+	window := word[:MaxWordSize]
+	whole := tr.t.Word(string(window))
+	raw := IsBytes(tr.t.from)
+
+	for size := len(window) - 1; size > len(window)/2; size-- {
+		if !raw && !utf8.RuneStart(window[size]) {
+			continue
+		}
+
+		if tr.t.Word(string(window[:size]))+tr.t.Word(string(window[size:])) == whole {
+			return size
+		}
+	}
+
+	size := MaxWordSize
+	if !raw {
+		for size > 0 && !utf8.RuneStart(word[size]) {
+			size--
+		}
+		// No rune starts in the window, so it is not valid UTF-8 and may
+		// be cut anywhere.
+		if size == 0 {
+			size = MaxWordSize
+		}
+	}
+
+	return size
+}
+
+func (tr *transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	if nDst, err = tr.flush(dst); err != nil {
+		return nDst, nSrc, err
+	}
+
+	for nSrc < len(src) {
+		rest := src[nSrc:]
+
+		size := bytes.IndexAny(rest, " \n")
+		sep := size >= 0
+
+		if !sep {
+			switch {
+			case len(rest) > MaxWordSize:
+				size = tr.cut(rest)
+			case atEOF:
+				size = len(rest)
+			default:
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+		}
+
+		word := string(rest[:size])
+
+		if tr.strict {
+			if v := utils.Check(tr.t.from, strings.TrimSuffix(word, "\r")); len(v) > 0 {
+				for i := range v {
+					v[i].Line = tr.line
+					v[i].Column += tr.column - 1
+					v[i].Offset += tr.offset
+				}
+
+				return nDst, nSrc, &UnmappedError{
+					From:     tr.t.from,
+					Unmapped: v,
+				}
+			}
+		}
+
+		out := tr.t.Word(word)
+		if sep {
+			out += string(rest[size])
+			size++
+		}
+
+		n := copy(dst[nDst:], out)
+		nDst += n
+		nSrc += size
+		tr.pending = append(tr.pending, out[n:]...)
+
+		tr.offset += size
+		if sep && rest[size-1] == '\n' {
+			tr.line++
+			tr.column = 1
+		} else {
+			tr.column += utf8.RuneCount(rest[:size])
+		}
+
+		if len(tr.pending) > 0 {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+	}
+
+	return nDst, nSrc, nil
+}
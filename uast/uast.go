@@ -0,0 +1,531 @@
+// Package uast transliterates Saṃskṛta text between UAST, romanisation
+// schemes and Brahmic scripts.
+//
+// The simplest entry point is [Transliterate]:
+//
+//	s, err := uast.Transliterate(uast.IAST, uast.DEVANĀGARĪ, "saṃskṛtam")
+//
+// When the same pair of schemes is used repeatedly, build a
+// [Transliterator] once with [New] and reuse it.
+package uast
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aneri0x4f/uast-cli/internal/utils"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Supported schemes
+const (
+	UAST         string = "uast"
+	IAST         string = "iast"
+	UAST_IO      string = "uast-io"
+	SLP1         string = "slp"
+	HK           string = "hk"
+	ITRANS       string = "itrans"
+	VELTHUIS     string = "velthuis"
+	WX           string = "wx"
+	ISO          string = "iso"
+	CYRILLIC     string = "cyrl"
+	KRUTI_DEV    string = "krutidev"
+	ISCII        string = "iscii"
+	GUJARATI     string = "gu"
+	TAMIL        string = "ta"
+	KANNADA      string = "kn"
+	ODIA         string = "or"
+	TELUGU       string = "te"
+	MALAYALAM    string = "ml"
+	DEVANĀGARĪ   string = "devanāgarī"
+	BENGALI      string = "bn"
+	ASSAMESE     string = "as"
+	GURMUKHI     string = "pa"
+	SINHALA      string = "si"
+	BRAHMI       string = "brah"
+	SHARADA      string = "shrd"
+	SIDDHAM      string = "sidd"
+	NEWA         string = "newa"
+	GRANTHA      string = "gran"
+	TAMIL_NATIVE string = "taml"
+	THAI         string = "thai"
+	KHMER        string = "khmr"
+	BALINESE     string = "bali"
+	JAVANESE     string = "java"
+	BURMESE      string = "mymr"
+	BRAILLE      string = "braille"
+	IPA          string = "ipa"
+	IPA_VEDIC    string = "ipa-vedic"
+)
+
+// Variants of Kannada, Telugu and Malayalam that write e and o with the long
+// letters and ĕ and ŏ with the short ones
+const (
+	KANNADA_LONG   string = "kn-long"
+	TELUGU_LONG    string = "te-long"
+	MALAYALAM_LONG string = "ml-long"
+)
+
+// ErrUnsupported is returned when a scheme, or a pair of schemes, cannot be
+// converted.
+var ErrUnsupported = errors.New("unsupported conversion")
+
+// Unmapped is a rune or sequence of the input that the source scheme has no
+// mapping for.
+type Unmapped = utils.Unmapped
+
+// UnmappedError is returned by the strict conversions when the input
+// contains sequences that would otherwise be silently dropped.
+type UnmappedError struct {
+	From     string
+	Unmapped []Unmapped
+}
+
+func (e *UnmappedError) Error() string {
+	var arr []string
+
+	for _, v := range e.Unmapped {
+		arr = append(
+			arr,
+			fmt.Sprintf(
+				"line %v, column %v (byte %v): %q",
+				v.Line,
+				v.Column,
+				v.Offset,
+				v.Text,
+			),
+		)
+	}
+
+	return fmt.Sprintf(
+		"%v unmapped sequence(s) in %v input: %v",
+		len(e.Unmapped),
+		e.From,
+		strings.Join(arr, "; "),
+	)
+}
+
+var aliases = map[string]string{
+	"devanagari": DEVANĀGARĪ,
+}
+
+// FromSchemes returns the schemes that can be used as a source.
+func FromSchemes() []string {
+	return utils.Sources()
+}
+
+// ToSchemes returns the schemes that can be used as a target.
+func ToSchemes() []string {
+	return utils.Targets()
+}
+
+// Loss is a letter that the target scheme cannot write distinctly, so that
+// converting the result back does not give the letter.
+type Loss = utils.Loss
+
+// SchemeFile is the JSON definition of a script. See the README for the
+// format.
+type SchemeFile = utils.SchemeFile
+
+// RegisterScheme adds a script, or replaces the tables of a script with the
+// same name. It affects every [Transliterator] created afterwards.
+func RegisterScheme(s SchemeFile) error {
+	return utils.RegisterScheme(s)
+}
+
+// LoadScheme reads a JSON scheme definition from r and registers it. It
+// returns the name of the scheme.
+func LoadScheme(r io.Reader) (string, error) {
+	return utils.LoadScheme(r)
+}
+
+// LoadSchemeDir registers every `*.json` scheme definition in dir and
+// returns their names.
+func LoadSchemeDir(dir string) ([]string, error) {
+	return utils.LoadSchemeDir(dir)
+}
+
+// Transliterator converts text from one scheme to another. It is safe for
+// concurrent use.
+type Transliterator struct {
+	from  string
+	to    string
+	funcs []func(string) string
+}
+
+// New returns a [Transliterator] converting from one scheme to another.
+func New(from, to string) (*Transliterator, error) {
+	if v, ok := aliases[from]; ok {
+		from = v
+	}
+	if v, ok := aliases[to]; ok {
+		to = v
+	}
+
+	if v := FromSchemes(); !slices.Contains(v, from) {
+		return nil, fmt.Errorf("%w: bad `from` value: %v: expected %v", ErrUnsupported, from, v)
+	}
+	if v := ToSchemes(); !slices.Contains(v, to) {
+		return nil, fmt.Errorf("%w: bad `to` value: %v: expected %v", ErrUnsupported, to, v)
+	}
+
+	k, ok := utils.Route(from, to)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v to %v", ErrUnsupported, from, to)
+	}
+
+	return &Transliterator{
+		from:  from,
+		to:    to,
+		funcs: k,
+	}, nil
+}
+
+// IPAConvention selects how [NewIPA] pronounces Saṃskṛta.
+type IPAConvention struct {
+	// Vedic rather than Classical pronunciation
+	Vedic bool
+	// Echo repeats the vowel before a visarga that ends a word, as in
+	// rāmaḥ → ɾaːmɐhɐ
+	Echo bool
+}
+
+// NewIPA returns a [Transliterator] converting to IPA with the given
+// conventions. New(from, IPA) and New(from, IPA_VEDIC) are the same without
+// echo vowels.
+func NewIPA(from string, c IPAConvention) (*Transliterator, error) {
+	to := IPA
+	if c.Vedic {
+		to = IPA_VEDIC
+	}
+
+	t, err := New(from, IAST)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transliterator{
+		from:  t.from,
+		to:    to,
+		funcs: append(t.funcs, utils.IPA(c.Vedic, c.Echo)),
+	}, nil
+}
+
+// Nasals selects how [NewNasals] writes a nasal before a stop.
+type Nasals int
+
+const (
+	// NasalsAsIs keeps nasals as they are written.
+	NasalsAsIs Nasals = iota
+	// NasalsClass writes anusvāra before a stop as the nasal of its class,
+	// as in saṃkalpa → saṅkalpa.
+	NasalsClass
+	// NasalsAnusvāra writes the nasal of a class before a stop of that class
+	// as anusvāra, as in saṅkalpa → saṃkalpa.
+	NasalsAnusvāra
+)
+
+// NewNasals returns a [Transliterator] that converts through UAST, writing
+// nasals before stops as n selects, so that every script and scheme spells
+// them alike.
+func NewNasals(from, to string, n Nasals) (*Transliterator, error) {
+	t, err := New(from, to)
+	if err != nil || n == NasalsAsIs {
+		return t, err
+	}
+
+	f := utils.AnusvāraToNasal
+	if n == NasalsAnusvāra {
+		f = utils.NasalToAnusvāra
+	}
+
+	if !utils.IsRoman(t.from) {
+		funcs, err := nasalRoute(t.from, t.to, f)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Transliterator{from: t.from, to: t.to, funcs: funcs}, nil
+	}
+
+	// UAST has no case, so romanisations go through IAST and get the case
+	// of their letters back there.
+	mid, err := nasalRoute(IAST, IAST, f)
+	if err != nil {
+		return nil, err
+	}
+	a, ok := utils.Route(t.from, IAST)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v to %v", ErrUnsupported, t.from, IAST)
+	}
+	b, ok := utils.Route(IAST, t.to)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v to %v", ErrUnsupported, IAST, t.to)
+	}
+
+	return &Transliterator{
+		from: t.from,
+		to:   t.to,
+		funcs: slices.Concat(
+			a,
+			[]func(string) string{utils.KeepCase(chain(mid))},
+			b,
+		),
+	}, nil
+}
+
+// Conversions from one scheme to another through UAST, applying f there
+func nasalRoute(from, to string, f func(string) string) ([]func(string) string, error) {
+	a, ok := utils.Route(from, UAST)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v to %v", ErrUnsupported, from, UAST)
+	}
+	b, ok := utils.Route(UAST, to)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v to %v", ErrUnsupported, UAST, to)
+	}
+
+	return slices.Concat(a, []func(string) string{f}, b), nil
+}
+
+// Apply conversions one after another
+func chain(funcs []func(string) string) func(string) string {
+	return func(s string) string {
+		for _, f := range funcs {
+			s = f(s)
+		}
+
+		return s
+	}
+}
+
+// NormaliseNasals rewrites the nasals before stops of text, as n selects,
+// keeping it in the same scheme.
+func NormaliseNasals(scheme string, n Nasals, text string) (string, error) {
+	t, err := NewNasals(scheme, scheme, n)
+	if err != nil {
+		return "", err
+	}
+
+	return t.Transliterate(text), nil
+}
+
+// From returns the source scheme.
+func (t *Transliterator) From() string {
+	return t.from
+}
+
+// To returns the target scheme.
+func (t *Transliterator) To() string {
+	return t.to
+}
+
+// IsBytes reports whether a scheme is a byte encoding rather than Unicode
+// text. Such text must not be normalised, as its bytes may happen to form
+// combining characters.
+func IsBytes(scheme string) bool {
+	return scheme == ISCII
+}
+
+// Normalise text of a scheme to NFC, leaving byte encodings alone
+func normalise(scheme, text string) string {
+	if IsBytes(scheme) {
+		return text
+	}
+
+	return norm.NFC.String(text)
+}
+
+// Move the positions of unmapped sequences found in the NFC form of text to
+// text itself. A sequence that starts or ends inside a character that NFC
+// changes covers the whole character.
+func denormalise(text string, unmapped []Unmapped) []Unmapped {
+	// Offsets of every segment into text and into its NFC form
+	var in, out []int
+	var same []bool
+
+	var it norm.Iter
+	it.InitString(norm.NFC, text)
+	for n := 0; !it.Done(); {
+		start := it.Pos()
+		seg := it.Next()
+
+		in = append(in, start)
+		out = append(out, n)
+		same = append(same, string(seg) == text[start:it.Pos()])
+		n += len(seg)
+	}
+	in = append(in, len(text))
+
+	// Find the offset into text of an offset into the NFC form, rounding
+	// down or up to a segment that NFC changes
+	at := func(o int, up bool) int {
+		i, ok := slices.BinarySearch(out, o)
+		switch {
+		case ok:
+			return in[i]
+		case same[i-1]:
+			return in[i-1] + o - out[i-1]
+		case up:
+			return in[i]
+		default:
+			return in[i-1]
+		}
+	}
+
+	ans := make([]Unmapped, len(unmapped))
+	for i, v := range unmapped {
+		start := at(v.Offset, false)
+		end := at(v.Offset+len(v.Text), true)
+
+		line := strings.LastIndexByte(text[:start], '\n') + 1
+		ans[i] = Unmapped{
+			Text:   text[start:end],
+			Line:   strings.Count(text[:start], "\n") + 1,
+			Column: utf8.RuneCountInString(text[line:start]) + 1,
+			Offset: start,
+		}
+	}
+
+	return ans
+}
+
+// Word converts a single word, i.e. text without spaces or newlines.
+func (t *Transliterator) Word(word string) string {
+	for _, f := range t.funcs {
+		word = f(word)
+	}
+
+	return word
+}
+
+// Transliterate converts text, which may span several lines. Words are
+// separated by spaces and converted independently.
+func (t *Transliterator) Transliterate(text string) string {
+	var ans []string
+
+	for i := range strings.SplitSeq(normalise(t.from, text), "\n") {
+		var arr []string
+
+		for j := range strings.SplitSeq(i, " ") {
+			arr = append(arr, t.Word(j))
+		}
+
+		ans = append(ans, normalise(t.to, strings.Join(arr, " ")))
+	}
+
+	return strings.Join(ans, "\n")
+}
+
+// Check reports every sequence of text that cannot be mapped from the source
+// scheme. The returned error, if any, is an [*UnmappedError].
+func (t *Transliterator) Check(text string) error {
+	if v := utils.Check(t.from, normalise(t.from, text)); len(v) > 0 {
+		if !IsBytes(t.from) {
+			v = denormalise(text, v)
+		}
+
+		return &UnmappedError{
+			From:     t.from,
+			Unmapped: v,
+		}
+	}
+
+	return nil
+}
+
+// TransliterateStrict is like [Transliterator.Transliterate] but fails with an
+// [*UnmappedError] instead of dropping what it cannot convert.
+func (t *Transliterator) TransliterateStrict(text string) (string, error) {
+	if err := t.Check(text); err != nil {
+		return "", err
+	}
+
+	return t.Transliterate(text), nil
+}
+
+// Lossy reports the letters of text that the target script or romanisation
+// cannot write distinctly, such as vocalic r in Gurmukhī or the Vedic
+// anusvāra in Harvard-Kyoto, in order of first occurrence.
+// It returns nil when the conversion can be reversed.
+func (t *Transliterator) Lossy(text string) []Loss {
+	return utils.Lossy(t.from, t.to, normalise(t.from, text))
+}
+
+// Akṣara is a syllable of text, with its byte offsets into the text.
+type Akṣara = utils.Akṣara
+
+// Akṣaras splits text in a scheme into orthographic syllables, where the
+// consonants before a vowel belong to it (dha-rma-kṣe-tre), and phonological
+// syllables, where all but the last close the syllable before
+// (dhar-mak-ṣet-re). Consonants that end a word join its last syllable.
+func Akṣaras(scheme, text string) (orthographic, phonological []Akṣara, err error) {
+	t, err := New(scheme, IAST)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orthographic, phonological = utils.Akṣaras(text, func(s string) string {
+		return t.Word(normalise(t.from, s))
+	})
+
+	return orthographic, phonological, nil
+}
+
+// DecodeISCII converts ISCII to Unicode, writing each part of b in the
+// script selected by the ATR code before it. Text before any ATR is
+// devanāgarī, as is a part whose script cannot be converted to. Use [New]
+// with [ISCII] to convert ISCII to a single scheme.
+func DecodeISCII(b []byte) string {
+	var ans []string
+
+	for _, v := range utils.ISCIIRuns(string(b)) {
+		t, err := New(ISCII, v.Script)
+		if err != nil {
+			t, _ = New(ISCII, DEVANĀGARĪ)
+		}
+
+		ans = append(ans, t.Transliterate(v.Text))
+	}
+
+	return strings.Join(ans, "")
+}
+
+// EncodeISCII converts text to ISCII. Text in a script that ISCII has an
+// ATR code for starts with that code, and other schemes are written as
+// devanāgarī.
+func EncodeISCII(from, text string) ([]byte, error) {
+	t, err := New(from, ISCII)
+	if err != nil {
+		return nil, err
+	}
+
+	atr, _ := utils.ISCIIATR(from)
+
+	return []byte(atr + t.Transliterate(text)), nil
+}
+
+// Transliterate converts text from one scheme to another.
+func Transliterate(from, to, text string) (string, error) {
+	t, err := New(from, to)
+	if err != nil {
+		return "", err
+	}
+
+	return t.Transliterate(text), nil
+}
+
+// TransliterateStrict converts text from one scheme to another, failing with
+// an [*UnmappedError] if any part of it cannot be mapped.
+func TransliterateStrict(from, to, text string) (string, error) {
+	t, err := New(from, to)
+	if err != nil {
+		return "", err
+	}
+
+	return t.TransliterateStrict(text)
+}
@@ -0,0 +1,711 @@
+package uast
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestTransliterate(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   IAST,
+			to:     DEVANĀGARĪ,
+			input:  "saṃskṛtam",
+			output: "संस्कृतम्",
+		},
+		{
+			from:   "devanagari",
+			to:     IAST,
+			input:  "धर्मक्षेत्रे कुरुक्षेत्रे\nसमवेता युयुत्सवः।",
+			output: "dharmakṣetre kurukṣetre\nsamavetā yuyutsavaḥ.",
+		},
+		{
+			from:   UAST,
+			to:     GUJARATI,
+			input:  "g/nl/e/su/a",
+			output: "ગણેશ",
+		},
+		{
+			from:   IAST,
+			to:     IAST,
+			input:  "rāma",
+			output: "rāma",
+		},
+		{
+			from:   UAST,
+			to:     DEVANĀGARĪ,
+			input:  "r/a/ma 12",
+			output: "राम १२",
+		},
+		{
+			from:   UAST,
+			to:     IAST,
+			input:  "r/a/ma 12",
+			output: "rāma 12",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.to+"__", func(t *testing.T) {
+			s, err := Transliterate(tC.from, tC.to, tC.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if s != tC.output {
+				t.Errorf("got %q, want %q", s, tC.output)
+			}
+		})
+	}
+}
+
+func TestNewUnsupported(t *testing.T) {
+	for _, v := range [][2]string{
+		{"foo", IAST},
+		{IAST, "bar"},
+		{IAST, UAST_IO},
+	} {
+		t.Run("__"+v[0]+"_"+v[1]+"__", func(t *testing.T) {
+			if _, err := New(v[0], v[1]); !errors.Is(err, ErrUnsupported) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTransliterateStrict(t *testing.T) {
+	if _, err := TransliterateStrict(IAST, DEVANĀGARĪ, "rāma"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := TransliterateStrict(IAST, DEVANĀGARĪ, "rāma\nkṛṣṇa Xyz")
+
+	var e *UnmappedError
+	if !errors.As(err, &e) {
+		t.Fatalf("got %v, want *UnmappedError", err)
+	}
+
+	want := []Unmapped{
+		{Text: "X", Line: 2, Column: 7, Offset: 18},
+		{Text: "z", Line: 2, Column: 9, Offset: 20},
+	}
+	if !slices.Equal(e.Unmapped, want) {
+		t.Errorf("got %v, want %v", e.Unmapped, want)
+	}
+
+	// Positions are in the input, not in its NFC form
+	_, err = TransliterateStrict(IAST, DEVANĀGARĪ, "rāma\nra\u0304ma\u0304 q")
+	if !errors.As(err, &e) {
+		t.Fatalf("got %v, want *UnmappedError", err)
+	}
+
+	want = []Unmapped{
+		{Text: "q", Line: 2, Column: 8, Offset: 15},
+	}
+	if !slices.Equal(e.Unmapped, want) {
+		t.Errorf("got %v, want %v", e.Unmapped, want)
+	}
+}
+
+func TestTransformer(t *testing.T) {
+	input := strings.Repeat("धर्मक्षेत्रे कुरुक्षेत्रे समवेता युयुत्सवः।\nमामकाः पाण्डवाश्चैव किमकुर्वत सञ्जय॥\n", 50)
+
+	tr, err := New(DEVANĀGARĪ, IAST)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := transform.NewReader(
+		iotest.OneByteReader(strings.NewReader(input)),
+		transform.Chain(norm.NFC, tr.Transformer(), norm.NFC),
+	)
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != tr.Transliterate(input) {
+		t.Errorf("got %q, want %q", b, tr.Transliterate(input))
+	}
+}
+
+// Run a transformer feeding it one byte at a time and giving it a small dst
+func transformSmall(t *testing.T, tr transform.Transformer, src string) string {
+	t.Helper()
+
+	var out, in []byte
+	dst := make([]byte, 16)
+
+	for i := 0; ; {
+		atEOF := i == len(src)
+
+		nDst, nSrc, err := tr.Transform(dst, in, atEOF)
+		out = append(out, dst[:nDst]...)
+		in = in[nSrc:]
+
+		switch {
+		case err == transform.ErrShortDst:
+			if nDst == 0 && nSrc == 0 {
+				t.Fatal("no progress on short dst")
+			}
+		case err == nil && atEOF:
+			return string(out)
+		case err == nil || err == transform.ErrShortSrc:
+			if atEOF {
+				t.Fatal("short src at EOF")
+			}
+			in = append(in, src[i])
+			i++
+		default:
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTransformerLongWord(t *testing.T) {
+	testCases := []struct {
+		from   string
+		to     string
+		input  string
+		output string
+	}{
+		{
+			from:   UAST_IO,
+			to:     DEVANĀGARĪ,
+			input:  strings.Repeat("ka", 500),
+			output: strings.Repeat("क", 500),
+		},
+		{
+			from:   ISCII,
+			to:     DEVANĀGARĪ,
+			input:  strings.Repeat("\xa4", 2000),
+			output: strings.Repeat("अ", 2000),
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.to+"__", func(t *testing.T) {
+			tr, err := New(tC.from, tC.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if s := transformSmall(t, tr.Transformer(), tC.input); s != tC.output {
+				t.Errorf("got %q, want %q", s, tC.output)
+			}
+		})
+	}
+
+	// Long words are cut between letters, so the result is that of
+	// Transliterate
+	for _, tC := range []struct {
+		from  string
+		input string
+	}{
+		{from: IAST, input: strings.Repeat("a", MaxWordSize-1) + "kha"},
+		{from: IAST, input: strings.Repeat("a", MaxWordSize-2) + "kṣa"},
+		{from: DEVANĀGARĪ, input: strings.Repeat("कि", 300)},
+		{from: UAST_IO, input: strings.Repeat("a", MaxWordSize-1) + "/kh/a"},
+	} {
+		t.Run("__"+tC.from+"_long__", func(t *testing.T) {
+			tr, err := New(tC.from, DEVANĀGARĪ)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := tr.Transliterate(tC.input)
+			if s := transformSmall(t, tr.Transformer(), tC.input); s != want {
+				t.Errorf("got %q, want %q", s, want)
+			}
+		})
+	}
+
+	t.Run("__invalid_utf8__", func(t *testing.T) {
+		tr, err := New(DEVANĀGARĪ, IAST)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Without rune starts, the word is cut at MaxWordSize.
+		piece := strings.Repeat("\xa4", MaxWordSize)
+		want := strings.Repeat(tr.Word(piece), 2)
+
+		if s := transformSmall(t, tr.Transformer(), piece+piece); s != want {
+			t.Errorf("got %q, want %q", s, want)
+		}
+	})
+}
+
+func TestStrictTransformer(t *testing.T) {
+	tr, err := New(IAST, DEVANĀGARĪ)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = transform.String(tr.StrictTransformer(), "rāma\nkṛṣṇa Xyz")
+
+	var e *UnmappedError
+	if !errors.As(err, &e) {
+		t.Fatalf("got %v, want *UnmappedError", err)
+	}
+
+	want := []Unmapped{
+		{Text: "X", Line: 2, Column: 7, Offset: 18},
+		{Text: "z", Line: 2, Column: 9, Offset: 20},
+	}
+	if !slices.Equal(e.Unmapped, want) {
+		t.Errorf("got %v, want %v", e.Unmapped, want)
+	}
+}
+
+// Run with -race: registering a scheme must not race with conversions
+func TestRegisterSchemeConcurrent(t *testing.T) {
+	tr, err := New(IAST, GUJARATI)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for range 20 {
+			if err := RegisterScheme(SchemeFile{Name: "gu-concurrent", Base: GUJARATI}); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	for range 20 {
+		if s, want := tr.Transliterate("saṃskṛtam"), "સંસ્કૃતમ્"; s != want {
+			t.Errorf("got %q, want %q", s, want)
+		}
+
+		tr.Lossy("saṃskṛtam")
+
+		if _, _, err := Akṣaras(GUJARATI, "સંસ્કૃતમ્"); err != nil {
+			t.Error(err)
+		}
+	}
+
+	wg.Wait()
+}
+
+func TestNewIPA(t *testing.T) {
+	tr, err := NewIPA(DEVANĀGARĪ, IPAConvention{Vedic: true, Echo: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tr.To() != IPA_VEDIC {
+		t.Errorf("got %q, want %q", tr.To(), IPA_VEDIC)
+	}
+
+	if v, want := tr.Transliterate("अग्निः"), "ɐɡn̪ihi"; v != want {
+		t.Errorf("got %q, want %q", v, want)
+	}
+
+	if v, want := tr.Transliterate("रामः। हरिः॥"), "ɾaːmɐhɐ| ɦɐɾihi‖"; v != want {
+		t.Errorf("got %q, want %q", v, want)
+	}
+}
+
+func TestISCII(t *testing.T) {
+	testCases := []struct {
+		from  string
+		input string
+		iscii string
+		text  string
+	}{
+		{
+			from:  DEVANĀGARĪ,
+			input: "संस्कृतम्",
+			iscii: "\xef\x42\xd7\xa2\xd7\xe8\xb3\xdf\xc2\xcc\xe8",
+			text:  "संस्कृतम्",
+		},
+		{
+			// मकमख is also valid UTF-8 for two combining marks
+			from:  IAST,
+			input: "makamakha",
+			iscii: "\xcc\xb3\xcc\xb4",
+			text:  "मकमख",
+		},
+		{
+			from:  GUJARATI,
+			input: "ૐ ગણેશાય",
+			iscii: "\xef\x4a\xa1\xe9 \xb5\xc1\xe1\xd5\xda\xcd",
+			text:  "ૐ ગણેશાય",
+		},
+		{
+			from:  BENGALI,
+			input: "অংশ",
+			iscii: "\xef\x43\xa4\xa2\xd5",
+			text:  "অংশ",
+		},
+		{
+			from:  DEVANĀGARĪ,
+			input: "रामः॥",
+			iscii: "\xef\x42\xcf\xda\xcc\xa3\xea\xea",
+			text:  "रामः॥",
+		},
+		{
+			from:  TAMIL_NATIVE,
+			input: "காமம்",
+			iscii: "\xef\x44\xb3\xda\xcc\xcc\xe8",
+			text:  "காமம்",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"_"+tC.input+"__", func(t *testing.T) {
+			b, err := EncodeISCII(tC.from, tC.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != tC.iscii {
+				t.Errorf("got %q, want %q", b, tC.iscii)
+			}
+
+			if s := DecodeISCII(b); s != tC.text {
+				t.Errorf("got %q, want %q", s, tC.text)
+			}
+		})
+	}
+}
+
+func TestDecodeISCIIScripts(t *testing.T) {
+	s := DecodeISCII([]byte("\xb3\xe8\xb7 \xef\x4a\xb3\xe8\xb7 \xef\x44\xb3\xda\xcc\xe8"))
+	if want := "क्ङ ક્ઙ காம்"; s != want {
+		t.Errorf("got %q, want %q", s, want)
+	}
+}
+
+func TestVedicAccents(t *testing.T) {
+	const input = "अ॒ग्निमी॑ळे पु॒रोहि॑तं दे॒वमृ॒त्विज᳚म् ऋ॑तम् ॠ॑ कॢ॑प्तम् दे॒वौ॑"
+
+	testCases := []struct {
+		to     string
+		output string
+	}{
+		{
+			to:     IAST,
+			output: "àgnimī́ḻe pùrohítaṃ dèvamṛ̀tvija̋m ṛ́tam ṝ́ kḷ́ptam dèváu",
+		},
+		{
+			to:     UAST,
+			output: "a\\`g-nim/i/\\'/ll/e pu\\`rohi\\'t/m/ de\\`vm/r/\\`t-vij\\/''/m- /r/\\'tm- /ru/\\' k/l/\\'p-tm- de\\`vau\\'",
+		},
+		{
+			to:     SLP1,
+			output: "a\\gnimI/Le pu\\rohi/taM de\\vamf\\tvija̋m f/tam F/ kx/ptam de\\vO/",
+		},
+		{
+			to:     ITRANS,
+			output: "a\\_gnimii\\'Le pu\\_rohi\\'taM de\\_vamRRi\\_tvija̋m RRi\\'tam RRI\\' kLLi\\'ptam de\\_vau\\'",
+		},
+		{
+			to:     HK,
+			output: "àgnimÍLe pùrohítaM dèvamR̀tvija̋m Ŕtam RŔ klŔptam dèváu",
+		},
+		{
+			to:     ISO,
+			output: "àgnimī́ḷē pùrōhítaṁ dḕvamr̥̀tvija̋m ŕ̥tam r̥̄́ kĺ̥ptam dḕváu",
+		},
+		{
+			to:     VELTHUIS,
+			output: "àgnimiíLe pùrohíta.m dèvam.r̀tvija̋m .ŕtam .rŕ k.ĺptam dèváu",
+		},
+		{
+			to:     WX,
+			output: "àgnimÍlYe pùrohíwaM xèvamq̀wvija̋m q́wam Q́ kĹpwam xèvÓ",
+		},
+		{
+			to:     CYRILLIC,
+			output: "а̀гнимӣ́л̱е пу̀рохи́там̣ дѐвамр̣̀твиджа̋м р̣́там р̣̄́ кл̣́птам дѐва́у",
+		},
+		{
+			to:     GRANTHA,
+			output: "𑌅॒𑌗𑍍𑌨𑌿𑌮𑍀॑𑌳𑍇 𑌪𑍁॒𑌰𑍋𑌹𑌿॑𑌤𑌂 𑌦𑍇॒𑌵𑌮𑍃॒𑌤𑍍𑌵𑌿𑌜᳚𑌮𑍍 𑌋॑𑌤𑌮𑍍 𑍠॑ 𑌕𑍢॑𑌪𑍍𑌤𑌮𑍍 𑌦𑍇॒𑌵𑍌॑",
+		},
+		{
+			to:     MALAYALAM,
+			output: "അ॒ഗ്നിമീ॑ളെ പു॒രൊഹി॑തം ദെ॒വമൃ॒ത്വിജ᳚മ് ഋ॑തമ് ൠ॑ കൢ॑പ്തമ് ദെ॒വൗ॑",
+		},
+		{
+			to:     TELUGU,
+			output: "అ॒గ్నిమీ॑ళె పు॒రొహి॑తం దె॒వమృ॒త్విజ᳚మ్ ఋ॑తమ్ ౠ॑ కౢ॑ప్తమ్ దె॒వౌ॑",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.to+"__", func(t *testing.T) {
+			s, err := Transliterate(DEVANĀGARĪ, tC.to, input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if s != tC.output {
+				t.Errorf("got %q, want %q", s, tC.output)
+			}
+
+			if s, _ = Transliterate(tC.to, DEVANĀGARĪ, s); s != input {
+				t.Errorf("got %q, want %q", s, input)
+			}
+		})
+	}
+}
+
+func TestVedicMarks(t *testing.T) {
+	const input = "सा᳐म ऋ᳛तम् अ᳕ग्निः नमᳲ सᳩ"
+
+	for _, to := range []string{UAST, IAST, ISO, SLP1, HK, ITRANS, VELTHUIS, WX, CYRILLIC, GRANTHA, MALAYALAM} {
+		t.Run("__"+to+"__", func(t *testing.T) {
+			s, err := TransliterateStrict(DEVANĀGARĪ, to, input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if s, err = TransliterateStrict(to, DEVANĀGARĪ, s); err != nil {
+				t.Fatal(err)
+			}
+
+			if s != input {
+				t.Errorf("got %q, want %q", s, input)
+			}
+		})
+	}
+}
+
+func TestNormaliseNasals(t *testing.T) {
+	testCases := []struct {
+		scheme string
+		n      Nasals
+		input  string
+		output string
+	}{
+		{
+			scheme: IAST,
+			n:      NasalsClass,
+			input:  "saṃkalpa saṃjaya saṃskṛta",
+			output: "saṅkalpa sañjaya saṃskṛta",
+		},
+		{
+			scheme: IAST,
+			n:      NasalsAnusvāra,
+			input:  "saṅkalpa sañjaya kaṇṭha ananta",
+			output: "saṃkalpa saṃjaya kaṃṭha anaṃta",
+		},
+		{
+			scheme: IAST,
+			n:      NasalsClass,
+			input:  "Saṃkalpa Saṃjaya",
+			output: "Saṅkalpa Sañjaya",
+		},
+		{
+			scheme: ISO,
+			n:      NasalsAnusvāra,
+			input:  "Saṅkalpa Ananta",
+			output: "Saṁkalpa Anaṁta",
+		},
+		{
+			scheme: DEVANĀGARĪ,
+			n:      NasalsClass,
+			input:  "संकल्प अंक",
+			output: "सङ्कल्प अङ्क",
+		},
+		{
+			scheme: DEVANĀGARĪ,
+			n:      NasalsAnusvāra,
+			input:  "सङ्कल्प अङ्क",
+			output: "संकल्प अंक",
+		},
+		{
+			scheme: GUJARATI,
+			n:      NasalsClass,
+			input:  "સંકલ્પ",
+			output: "સઙ્કલ્પ",
+		},
+		{
+			scheme: SLP1,
+			n:      NasalsAnusvāra,
+			input:  "saNkalpa",
+			output: "saMkalpa",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.scheme+"_"+tC.input+"__", func(t *testing.T) {
+			s, err := NormaliseNasals(tC.scheme, tC.n, tC.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if s != tC.output {
+				t.Errorf("got %q, want %q", s, tC.output)
+			}
+		})
+	}
+}
+
+func TestNormaliseNasalsKeepsText(t *testing.T) {
+	testCases := []struct {
+		scheme string
+		input  string
+	}{
+		{
+			scheme: IAST,
+			input:  "sat, vāk? (sat) rāmaḥ| dharmakṣetre 12",
+		},
+		{
+			scheme: IAST,
+			input:  "Rāma Kṛṣṇa Ārya",
+		},
+		{
+			scheme: ISO,
+			input:  "Rāma Kr̥ṣṇa Ārya",
+		},
+		{
+			scheme: DEVANĀGARĪ,
+			input:  "सत्, वाक्? (सत्) रामः। धर्मक्षेत्रे १२",
+		},
+		{
+			scheme: SLP1,
+			input:  "sat, vAk? (sat) rAmaH.",
+		},
+		{
+			scheme: GUJARATI,
+			input:  "સત્, વાક્? (સત્)",
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.scheme+"_"+tC.input+"__", func(t *testing.T) {
+			for _, n := range []Nasals{NasalsClass, NasalsAnusvāra} {
+				s, err := NormaliseNasals(tC.scheme, n, tC.input)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if s != tC.input {
+					t.Errorf("got %q, want %q", s, tC.input)
+				}
+			}
+		})
+	}
+}
+
+func TestAkṣaras(t *testing.T) {
+	testCases := []struct {
+		from         string
+		input        string
+		orthographic []Akṣara
+		phonological []Akṣara
+	}{
+		{
+			from:  IAST,
+			input: "dharmakṣetre",
+			orthographic: []Akṣara{
+				{Text: "dha", IAST: "dha", Start: 0, End: 3},
+				{Text: "rma", IAST: "rma", Start: 3, End: 6},
+				{Text: "kṣe", IAST: "kṣe", Start: 6, End: 11},
+				{Text: "tre", IAST: "tre", Start: 11, End: 14},
+			},
+			phonological: []Akṣara{
+				{Text: "dhar", IAST: "dhar", Start: 0, End: 4},
+				{Text: "mak", IAST: "mak", Start: 4, End: 7},
+				{Text: "ṣet", IAST: "ṣet", Start: 7, End: 12},
+				{Text: "re", IAST: "re", Start: 12, End: 14},
+			},
+		},
+		{
+			from:  DEVANĀGARĪ,
+			input: "।धर्म अंशः",
+			orthographic: []Akṣara{
+				{Text: "ध", IAST: "dha", Start: 3, End: 6},
+				{Text: "र्म", IAST: "rma", Start: 6, End: 15},
+				{Text: "अं", IAST: "aṃ", Start: 16, End: 22},
+				{Text: "शः", IAST: "śaḥ", Start: 22, End: 28},
+			},
+			phonological: []Akṣara{
+				{Text: "धर्", IAST: "dhar", Start: 3, End: 12},
+				{Text: "म", IAST: "ma", Start: 12, End: 15},
+				{Text: "अं", IAST: "aṃ", Start: 16, End: 22},
+				{Text: "शः", IAST: "śaḥ", Start: 22, End: 28},
+			},
+		},
+		{
+			from:  SLP1,
+			input: "Darma\nkzetre",
+			orthographic: []Akṣara{
+				{Text: "Da", IAST: "dha", Start: 0, End: 2},
+				{Text: "rma", IAST: "rma", Start: 2, End: 5},
+				{Text: "kze", IAST: "kṣe", Start: 6, End: 9},
+				{Text: "tre", IAST: "tre", Start: 9, End: 12},
+			},
+			phonological: []Akṣara{
+				{Text: "Dar", IAST: "dhar", Start: 0, End: 3},
+				{Text: "ma", IAST: "ma", Start: 3, End: 5},
+				{Text: "kzet", IAST: "kṣet", Start: 6, End: 10},
+				{Text: "re", IAST: "re", Start: 10, End: 12},
+			},
+		},
+		{
+			from:  ISCII,
+			input: "\xc5\xcf\xe8\xcc",
+			orthographic: []Akṣara{
+				{Text: "\xc5", IAST: "dha", Start: 0, End: 1},
+				{Text: "\xcf\xe8\xcc", IAST: "rma", Start: 1, End: 4},
+			},
+			phonological: []Akṣara{
+				{Text: "\xc5\xcf\xe8", IAST: "dhar", Start: 0, End: 3},
+				{Text: "\xcc", IAST: "ma", Start: 3, End: 4},
+			},
+		},
+	}
+	for _, tC := range testCases {
+		t.Run("__"+tC.from+"__", func(t *testing.T) {
+			o, p, err := Akṣaras(tC.from, tC.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(o, tC.orthographic) {
+				t.Errorf("got %v, want %v", o, tC.orthographic)
+			}
+
+			if !slices.Equal(p, tC.phonological) {
+				t.Errorf("got %v, want %v", p, tC.phonological)
+			}
+		})
+	}
+}
+
+func TestAkṣarasLongWord(t *testing.T) {
+	input := strings.Repeat("धर्मक्षेत्रे", 400)
+
+	o, p, err := Akṣaras(DEVANĀGARĪ, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(o) != 1600 || len(p) != 1600 {
+		t.Fatalf("got %d and %d syllables, want 1600", len(o), len(p))
+	}
+
+	for i, v := range o {
+		if want := []string{"ध", "र्म", "क्षे", "त्रे"}[i%4]; v.Text != want {
+			t.Fatalf("got %q at %d, want %q", v.Text, i, want)
+		}
+	}
+}